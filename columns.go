@@ -0,0 +1,186 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// DefaultColumns is the list layout used when the config sets none
+var DefaultColumns = []string{"status", "name", "time", "offset", "work_countdown", "date", "dst"}
+
+// listColumn describes one column of the colleague list
+type listColumn struct {
+	value func(m Model, ct ColleagueTime) string
+	style func(ct ColleagueTime) lipgloss.Style // nil = unstyled
+}
+
+// listColumns are the columns available to the config's columns list
+var listColumns = map[string]listColumn{
+	"status": {value: func(_ Model, ct ColleagueTime) string { return statusGlyph(ct) }},
+	"name":   {value: func(_ Model, ct ColleagueTime) string { return ct.Colleague.Name }},
+	"time": {
+		value: func(m Model, ct ColleagueTime) string { return FormatTime(ct.CurrentTime, m.config.TimeFormat) },
+		style: getNameStyle,
+	},
+	"offset": {
+		value: func(_ Model, ct ColleagueTime) string { return ct.Offset },
+		style: func(ColleagueTime) lipgloss.Style { return offsetStyle },
+	},
+	"utc_offset": {
+		value: func(_ Model, ct ColleagueTime) string { return formatUTCOffset(ct.CurrentTime) },
+		style: func(ColleagueTime) lipgloss.Style { return offsetStyle },
+	},
+	"abbreviation": {
+		value: func(_ Model, ct ColleagueTime) string { return ct.CurrentTime.Format("MST") },
+		style: func(ColleagueTime) lipgloss.Style { return dateStyle },
+	},
+	"timezone": {
+		value: func(_ Model, ct ColleagueTime) string { return ct.Colleague.Timezone },
+		style: func(ColleagueTime) lipgloss.Style { return dateStyle },
+	},
+	"work_countdown": {
+		value: func(_ Model, ct ColleagueTime) string { return workCountdown(ct) },
+		style: getNameStyle,
+	},
+	"work_hours": {
+		value: func(_ Model, ct ColleagueTime) string {
+			return fmt.Sprintf("%d-%d", ct.Colleague.GetWorkStart(), ct.Colleague.GetWorkEnd())
+		},
+		style: func(ColleagueTime) lipgloss.Style { return dateStyle },
+	},
+	"weekday": {
+		value: func(_ Model, ct ColleagueTime) string { return ct.CurrentTime.Format("Mon") },
+		style: func(ColleagueTime) lipgloss.Style { return dateStyle },
+	},
+	"date": {
+		value: func(_ Model, ct ColleagueTime) string { return FormatDate(ct.CurrentTime) },
+		style: func(ColleagueTime) lipgloss.Style { return dateStyle },
+	},
+	"dst": {
+		value: func(_ Model, ct ColleagueTime) string {
+			if !ct.HasDSTChange {
+				return ""
+			}
+			return formatDSTWarning(ct.DSTDeltaHours, ct.DSTChangeAt)
+		},
+		style: func(ColleagueTime) lipgloss.Style { return offsetStyle },
+	},
+	"tags": {
+		value: func(_ Model, ct ColleagueTime) string { return strings.Join(ct.Colleague.Tags, ", ") },
+		style: func(ColleagueTime) lipgloss.Style { return dateStyle },
+	},
+}
+
+// ColumnNames returns the available list columns in a stable order
+func ColumnNames() []string {
+	return []string{"status", "name", "time", "offset", "utc_offset", "abbreviation", "timezone",
+		"work_countdown", "work_hours", "weekday", "date", "dst", "tags"}
+}
+
+// ValidateColumns checks a columns list for unknown or repeated names.
+// The name column is required: it is what edit and delete act on.
+func ValidateColumns(columns []string) error {
+	seen := make(map[string]bool, len(columns))
+	for _, c := range columns {
+		if _, ok := listColumns[c]; !ok {
+			return fmt.Errorf("unknown column %q (available: %s)", c, strings.Join(ColumnNames(), ", "))
+		}
+		if seen[c] {
+			return fmt.Errorf("column %q listed twice", c)
+		}
+		seen[c] = true
+	}
+	if len(columns) > 0 && !seen["name"] {
+		return fmt.Errorf("columns must include name")
+	}
+	return nil
+}
+
+// activeColumns returns the configured columns, or the defaults
+func (m Model) activeColumns() []string {
+	if len(m.config.Columns) == 0 {
+		return DefaultColumns
+	}
+	return m.config.Columns
+}
+
+// statusGlyph returns the working/off-hours/weekend indicator
+func statusGlyph(ct ColleagueTime) string {
+	switch {
+	case ct.IsWeekend:
+		return "◆"
+	case ct.IsWorkingTime:
+		return "●"
+	default:
+		return "○"
+	}
+}
+
+// formatUTCOffset formats a time's zone offset, e.g. "UTC+5:30", "UTC-8", "UTC"
+func formatUTCOffset(t time.Time) string {
+	_, offset := t.Zone()
+	if offset == 0 {
+		return "UTC"
+	}
+	sign := "+"
+	if offset < 0 {
+		sign = "-"
+		offset = -offset
+	}
+	h, m := offset/3600, offset%3600/60
+	if m == 0 {
+		return fmt.Sprintf("UTC%s%d", sign, h)
+	}
+	return fmt.Sprintf("UTC%s%d:%02d", sign, h, m)
+}
+
+// workCountdown describes ct.NextWorkChange, e.g. "ends in 2h10m", or
+// "starts in 9h (Mon)" when they start on a later day
+func workCountdown(ct ColleagueTime) string {
+	next := ct.NextWorkChange
+	if next.IsZero() {
+		return ""
+	}
+	in := formatCountdown(next.Sub(ct.CurrentTime))
+	if ct.IsWorkingTime {
+		return "ends in " + in
+	}
+	if next.YearDay() != ct.CurrentTime.YearDay() || next.Year() != ct.CurrentTime.Year() {
+		return fmt.Sprintf("starts in %s (%s)", in, next.Format("Mon"))
+	}
+	return "starts in " + in
+}
+
+// atHour returns hour:00 on t's calendar day, in t's location
+func atHour(t time.Time, hour int) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), hour, 0, 0, 0, t.Location())
+}
+
+// isWeekendDay reports whether t falls on a Saturday or Sunday
+func isWeekendDay(t time.Time) bool {
+	return t.Weekday() == time.Saturday || t.Weekday() == time.Sunday
+}
+
+// formatCountdown formats a duration to the minute, e.g. "45m", "3h",
+// "2h15m", "1d", "2d4h"
+func formatCountdown(d time.Duration) string {
+	d = d.Round(time.Minute)
+	days := int(d / (24 * time.Hour))
+	hours := int(d % (24 * time.Hour) / time.Hour)
+	minutes := int(d % time.Hour / time.Minute)
+	switch {
+	case days > 0 && hours > 0:
+		return fmt.Sprintf("%dd%dh", days, hours)
+	case days > 0:
+		return fmt.Sprintf("%dd", days)
+	case hours > 0 && minutes > 0:
+		return fmt.Sprintf("%dh%02dm", hours, minutes)
+	case hours > 0:
+		return fmt.Sprintf("%dh", hours)
+	default:
+		return fmt.Sprintf("%dm", minutes)
+	}
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mattn/go-runewidth"
+)
+
+func TestFormatUTCOffset(t *testing.T) {
+	tests := []struct {
+		zone string
+		want string
+	}{
+		{"UTC", "UTC"},
+		{"Asia/Kolkata", "UTC+5:30"},
+		{"Asia/Kathmandu", "UTC+5:45"},
+		{"Asia/Tokyo", "UTC+9"},
+		{"Pacific/Honolulu", "UTC-10"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.zone, func(t *testing.T) {
+			loc, err := time.LoadLocation(tt.zone)
+			if err != nil {
+				t.Fatalf("LoadLocation failed: %v", err)
+			}
+			if got := formatUTCOffset(time.Now().In(loc)); got != tt.want {
+				t.Errorf("formatUTCOffset() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWorkCountdown(t *testing.T) {
+	loc := time.UTC
+	tests := []struct {
+		name string
+		now  time.Time // 2024-01-05 is a Friday
+		want string
+	}{
+		{"working", time.Date(2024, 1, 5, 14, 50, 0, 0, loc), "ends in 2h10m"},
+		{"before work", time.Date(2024, 1, 5, 8, 30, 0, 0, loc), "starts in 30m"},
+		{"after work skips weekend", time.Date(2024, 1, 5, 18, 0, 0, 0, loc), "starts in 2d15h (Mon)"},
+		{"weekend", time.Date(2024, 1, 7, 23, 0, 0, 0, loc), "starts in 10h (Mon)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := Colleague{}
+			ct := ColleagueTime{
+				Colleague:      c,
+				CurrentTime:    tt.now,
+				IsWorkingTime:  !isWeekendDay(tt.now) && isInTimeRange(tt.now.Hour(), c.GetWorkStart(), c.GetWorkEnd()),
+				NextWorkChange: nextWorkChange(c, tt.now),
+			}
+			if got := workCountdown(ct); got != tt.want {
+				t.Errorf("workCountdown() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNextWorkChange(t *testing.T) {
+	loc := time.UTC
+	tests := []struct {
+		name       string
+		start, end int
+		now        time.Time // 2024-01-04 is a Thursday
+		want       time.Time
+	}{
+		{"overnight shift ends at midnight", 16, 0, time.Date(2024, 1, 4, 23, 30, 0, 0, loc), time.Date(2024, 1, 5, 0, 0, 0, 0, loc)},
+		{"wraparound starts tonight", 22, 6, time.Date(2024, 1, 4, 12, 0, 0, 0, loc), time.Date(2024, 1, 4, 22, 0, 0, 0, loc)},
+		{"Friday night shift stops at the weekend", 22, 6, time.Date(2024, 1, 5, 23, 0, 0, 0, loc), time.Date(2024, 1, 6, 0, 0, 0, 0, loc)},
+		{"empty hours never change", 9, 9, time.Date(2024, 1, 4, 12, 0, 0, 0, loc), time.Time{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := Colleague{WorkStart: HourPtr(tt.start), WorkEnd: HourPtr(tt.end)}
+			if got := nextWorkChange(c, tt.now); !got.Equal(tt.want) {
+				t.Errorf("nextWorkChange() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateColumns(t *testing.T) {
+	tests := []struct {
+		name    string
+		columns []string
+		wantErr bool
+	}{
+		{"default (unset)", nil, false},
+		{"custom", []string{"name", "utc_offset", "tags"}, false},
+		{"unknown", []string{"name", "shoe_size"}, true},
+		{"repeated", []string{"name", "time", "time"}, true},
+		{"without name", []string{"time"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateColumns(tt.columns)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateColumns(%v) error = %v, wantErr %v", tt.columns, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConfiguredColumnsRender(t *testing.T) {
+	m, _ := newReloadTestModel(t)
+	m.config.Columns = []string{"name", "timezone", "work_hours", "tags"}
+	m.config.Colleagues[0].Tags = []string{"oncall", "design"}
+	m.config.Colleagues[1].WorkStart = HourPtr(8)
+	m.updateColleagueTimes()
+
+	layout := m.listLayout()
+	var widths []int
+	for i, ct := range m.colleagues {
+		row := stripANSI(m.renderColleagueRow(i, ct, layout))
+		widths = append(widths, runewidth.StringWidth(row))
+
+		if !strings.Contains(row, ct.Colleague.Timezone) {
+			t.Errorf("row %q missing timezone column", row)
+		}
+		if strings.ContainsAny(row, "●○◆") {
+			t.Errorf("row %q shows the status column, which is not configured", row)
+		}
+	}
+	if !strings.Contains(stripANSI(m.renderColleagueRow(0, m.colleagues[0], layout)), "oncall, design") {
+		t.Error("Expected tags in the first row")
+	}
+	if !strings.Contains(stripANSI(m.renderColleagueRow(1, m.colleagues[1], layout)), "8-17") {
+		t.Error("Expected work hours 8-17 in the second row")
+	}
+	// Trailing cells are padded, so aligned rows are equally wide
+	for i := 1; i < len(widths); i++ {
+		if widths[i] != widths[0] {
+			t.Errorf("row widths %v, want all equal", widths)
+			break
+		}
+	}
+}
@@ -0,0 +1,333 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+)
+
+// MaxCommandHistory is how many ':' command lines are remembered for
+// the session
+const MaxCommandHistory = 50
+
+// command is one ':' command. Commands call the same model methods as
+// the key handlers, so they save, record undo steps and report errors
+// the same way.
+type command struct {
+	usage    string
+	run      func(m *Model, args []string) error
+	complete func(m Model) []string // Candidates for the first argument; nil = none
+}
+
+// commands are the ':' commands by name
+var commands = map[string]command{
+	"add": {
+		usage: `add <name> <city>  (quote multi-word values: add "Dana S" "New York")`,
+		run: func(m *Model, args []string) error {
+			if len(args) != 2 {
+				return fmt.Errorf(`usage: add <name> <city>, e.g. add Dana Berlin or add "Dana S" "New York"`)
+			}
+			results := SearchTimezones(args[1])
+			if len(results) == 0 {
+				return fmt.Errorf("no timezone found for %q", args[1])
+			}
+			m.searchQuery = args[1] // Shapes the display name, as in the add flow
+			return m.addColleagueFromSearch(args[0], results[0])
+		},
+	},
+	"goto": {
+		usage: "goto <time>  (e.g. 14:00, tue 10:30, +3d; shown in the timeline)",
+		run: func(m *Model, args []string) error {
+			now := time.Now()
+			target, err := parseGotoTarget(strings.Join(args, " "), now, m.referenceTimezone())
+			if err != nil {
+				return err
+			}
+			m.timeOffset = target.Sub(now)
+			m.inputMode = ModeTimeline
+			return nil
+		},
+	},
+	"scheme": {
+		usage:    "scheme <name>",
+		run:      oneArg("scheme", (*Model).setColorScheme),
+		complete: func(Model) []string { return GetAvailableColorSchemes() },
+	},
+	"mode": {
+		usage:    "mode <individual|shared>",
+		run:      oneArg("mode", (*Model).setTimelineMode),
+		complete: func(Model) []string { return []string{"individual", "shared"} },
+	},
+	"window": {
+		usage:    "window <hours|center|day>  (e.g. 36h; center on now or start at midnight)",
+		run:      oneArg("window", (*Model).setTimelineWindow),
+		complete: func(Model) []string { return []string{"12h", "24h", "36h", "48h", "center", "day"} },
+	},
+	"labels": {
+		usage:    "labels <off|own|local>  (individual mode: label each row on their clock or yours)",
+		run:      oneArg("labels", (*Model).setRowLabels),
+		complete: func(Model) []string { return []string{"off", RowLabelsOwn, RowLabelsLocal} },
+	},
+	"sort": {
+		usage:    "sort <" + strings.Join(SortModes, "|") + ">",
+		run:      oneArg("sort", (*Model).setSortBy),
+		complete: func(Model) []string { return SortModes },
+	},
+	"format": {
+		usage:    "format <12h|24h>",
+		run:      oneArg("format", (*Model).setTimeFormat),
+		complete: func(Model) []string { return []string{"12h", "24h"} },
+	},
+	"filter": {
+		usage: "filter [query]  (tag:name matches tags; no query clears)",
+		run: func(m *Model, args []string) error {
+			m.setFilter(strings.Join(args, " "))
+			return nil
+		},
+		complete: func(m Model) []string {
+			var tags []string
+			seen := make(map[string]bool)
+			for _, c := range m.config.Colleagues {
+				for _, t := range c.Tags {
+					if !seen[t] {
+						seen[t] = true
+						tags = append(tags, "tag:"+t)
+					}
+				}
+			}
+			sort.Strings(tags)
+			return tags
+		},
+	},
+	"profile": {
+		usage:    "profile <name>",
+		run:      oneArg("profile", (*Model).switchProfile),
+		complete: func(m Model) []string { return m.fullConfig.ProfileNames() },
+	},
+	"undo": {usage: "undo", run: noArgs("undo", (*Model).undo)},
+	"redo": {usage: "redo", run: noArgs("redo", (*Model).redo)},
+	"week": {
+		usage: "week  (heatmap of team overlap)",
+		run: func(m *Model, args []string) error {
+			m.openWeek()
+			return nil
+		},
+	},
+	"trash": {
+		usage: "trash",
+		run: func(m *Model, args []string) error {
+			m.openTrash()
+			return nil
+		},
+	},
+	"help": {
+		usage: "help",
+		run: func(m *Model, args []string) error {
+			m.inputMode = ModeHelp
+			return nil
+		},
+	},
+}
+
+// oneArg adapts a setter taking one value into a command
+func oneArg(name string, set func(*Model, string) error) func(*Model, []string) error {
+	return func(m *Model, args []string) error {
+		if len(args) != 1 {
+			return fmt.Errorf("%s takes one argument", name)
+		}
+		return set(m, args[0])
+	}
+}
+
+// noArgs adapts an argument-less action into a command
+func noArgs(name string, action func(*Model) error) func(*Model, []string) error {
+	return func(m *Model, args []string) error {
+		if len(args) != 0 {
+			return fmt.Errorf("%s takes no arguments", name)
+		}
+		return action(m)
+	}
+}
+
+// commandNames returns the command names in alphabetical order
+func commandNames() []string {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// splitCommandLine splits a command line into words; double quotes
+// group words ("New York")
+func splitCommandLine(line string) ([]string, error) {
+	var words []string
+	var word strings.Builder
+	inWord, quoted := false, false
+	for _, r := range line {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			inWord = true
+		case r == ' ' && !quoted:
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+	if quoted {
+		return nil, fmt.Errorf("unterminated quote")
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
+
+// runCommand parses and runs a command line
+func (m *Model) runCommand(line string) error {
+	words, err := splitCommandLine(line)
+	if err != nil || len(words) == 0 {
+		return err
+	}
+	cmd, ok := commands[words[0]]
+	if !ok {
+		return fmt.Errorf("unknown command %q (available: %s)", words[0], strings.Join(commandNames(), ", "))
+	}
+	return cmd.run(m, words[1:])
+}
+
+// newCommandInput creates the input for the ':' command line
+func newCommandInput() textinput.Model {
+	input := textinput.New()
+	input.Placeholder = "command (Tab completes)"
+	input.CharLimit = 100
+	input.Width = 60
+	input.Prompt = ""
+	return input
+}
+
+// openCommandLine shows the ':' prompt over the current view; it
+// returns to that view when closed
+func (m *Model) openCommandLine() {
+	m.returnMode = m.inputMode
+	m.inputMode = ModeCommand
+	m.nameInput = newCommandInput()
+	m.nameInput.Focus()
+	m.commandHistoryPos = len(m.commandHistory)
+	m.commandHints = nil
+	m.errorMsg = ""
+}
+
+// submitCommandLine closes the prompt, remembers the line and runs it
+func (m *Model) submitCommandLine() {
+	line := strings.TrimSpace(m.nameInput.Value())
+	m.inputMode = m.returnMode
+	m.commandHints = nil
+	if line == "" {
+		return
+	}
+	if n := len(m.commandHistory); n == 0 || m.commandHistory[n-1] != line {
+		m.commandHistory = append(m.commandHistory, line)
+		if len(m.commandHistory) > MaxCommandHistory {
+			m.commandHistory = m.commandHistory[1:]
+		}
+	}
+	if err := m.runCommand(line); err != nil {
+		m.errorMsg = err.Error()
+	}
+}
+
+// browseCommandHistory steps through earlier command lines (delta -1
+// older, +1 newer); stepping past the newest clears the line
+func (m *Model) browseCommandHistory(delta int) {
+	pos := m.commandHistoryPos + delta
+	if pos < 0 || pos > len(m.commandHistory) {
+		return
+	}
+	m.commandHistoryPos = pos
+	line := ""
+	if pos < len(m.commandHistory) {
+		line = m.commandHistory[pos]
+	}
+	m.nameInput.SetValue(line)
+	m.nameInput.CursorEnd()
+}
+
+// completeCommandLine completes the command name or its first
+// argument: a single candidate is filled in, several are extended to
+// their common prefix and listed as hints. Commands without
+// candidates show their usage instead.
+func (m *Model) completeCommandLine() {
+	line := m.nameInput.Value()
+	name, arg, hasArg := strings.Cut(line, " ")
+
+	var prefix string
+	var candidates []string
+	if !hasArg {
+		prefix, candidates = name, commandNames()
+	} else if cmd, ok := commands[name]; ok && cmd.complete != nil && !strings.Contains(arg, " ") {
+		prefix, candidates = arg, cmd.complete(*m)
+	}
+
+	var matches []string
+	for _, c := range candidates {
+		if strings.HasPrefix(c, prefix) {
+			matches = append(matches, c)
+		}
+	}
+	m.commandHints = nil
+	switch len(matches) {
+	case 0:
+		// Nothing to complete: show how the command is used
+		if cmd, ok := commands[name]; ok && hasArg {
+			m.commandHints = []string{cmd.usage}
+		}
+		return
+	case 1:
+		if hasArg {
+			line = name + " " + matches[0]
+		} else {
+			line = matches[0] + " "
+		}
+	default:
+		common := matches[0]
+		for _, c := range matches[1:] {
+			for !strings.HasPrefix(c, common) {
+				common = common[:len(common)-1]
+			}
+		}
+		if hasArg {
+			line = name + " " + common
+		} else {
+			line = common
+		}
+		m.commandHints = matches
+	}
+	m.nameInput.SetValue(line)
+	m.nameInput.CursorEnd()
+}
+
+// renderCommandPrompt renders the ':' command line shown in place of
+// the footer
+func (m Model) renderCommandPrompt() string {
+	var b strings.Builder
+	b.WriteString(promptStyle.Render(":"))
+	b.WriteString(m.nameInput.View())
+	b.WriteString("\n")
+	if len(m.commandHints) > 0 {
+		b.WriteString(footerStyle.Render(strings.Join(m.commandHints, "  ")))
+	} else {
+		b.WriteString(footerStyle.Render("Tab complete • ↑/↓ history • Enter run • Esc cancel"))
+	}
+	return b.String()
+}
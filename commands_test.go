@@ -0,0 +1,135 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// typeCommand opens the command line and types line into it
+func typeCommand(t *testing.T, m Model, line string) Model {
+	t.Helper()
+	next, _ := m.handleNormalMode(keyMsg(":"))
+	m = next.(Model)
+	if m.inputMode != ModeCommand {
+		t.Fatalf("Expected command mode, got %v", m.inputMode)
+	}
+	next, _ = m.handleCommandMode(keyMsg(line))
+	return next.(Model)
+}
+
+func TestSplitCommandLine(t *testing.T) {
+	tests := []struct {
+		line    string
+		want    []string
+		wantErr bool
+	}{
+		{"sort offset", []string{"sort", "offset"}, false},
+		{`add "Dana S"  "New York"`, []string{"add", "Dana S", "New York"}, false},
+		{`add ""`, []string{"add", ""}, false},
+		{`add "Dana`, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.line, func(t *testing.T) {
+			got, err := splitCommandLine(tt.line)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("splitCommandLine(%q) error = %v, wantErr %v", tt.line, err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("splitCommandLine(%q) = %q, want %q", tt.line, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCommands(t *testing.T) {
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+	m, path := newReloadTestModel(t) // Alice (New York), Bob (London), Charlie (Tokyo)
+
+	for _, line := range []string{"scheme nord", "mode shared", "sort offset", "format 12h", `add Dana Berlin`} {
+		m = typeCommand(t, m, line)
+		next, _ := m.handleCommandMode(enter)
+		m = next.(Model)
+		if m.errorMsg != "" {
+			t.Fatalf(":%s failed: %s", line, m.errorMsg)
+		}
+	}
+	loaded, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig failed: %v", err)
+	}
+	if loaded.ColorScheme != "nord" || loaded.TimelineMode != "shared" || loaded.SortBy != SortByOffset || loaded.TimeFormat != "12h" {
+		t.Errorf("saved settings = %s/%s/%s/%s", loaded.ColorScheme, loaded.TimelineMode, loaded.SortBy, loaded.TimeFormat)
+	}
+	if n := len(loaded.Colleagues); n != 4 || loaded.Colleagues[3].Timezone != "Europe/Berlin" {
+		t.Errorf("Expected Dana added in Berlin, got %+v", loaded.Colleagues)
+	}
+
+	// Commands are undoable like their keys
+	if err := m.undo(); err != nil || len(m.config.Colleagues) != 3 {
+		t.Errorf("undo after :add: err %v, %d colleagues", err, len(m.config.Colleagues))
+	}
+
+	// Errors go to errorMsg
+	m = typeCommand(t, m, "scheme nope")
+	next, _ := m.handleCommandMode(enter)
+	m = next.(Model)
+	if !strings.Contains(m.errorMsg, "unknown color scheme") || m.inputMode != ModeNormal {
+		t.Errorf("errorMsg = %q, mode %v", m.errorMsg, m.inputMode)
+	}
+
+	// History: up recalls the last line
+	m = typeCommand(t, m, "")
+	next, _ = m.handleCommandMode(tea.KeyMsg{Type: tea.KeyUp})
+	m = next.(Model)
+	if got := m.nameInput.Value(); got != "scheme nope" {
+		t.Errorf("history recall = %q, want %q", got, "scheme nope")
+	}
+}
+
+func TestGotoCommand(t *testing.T) {
+	m, _ := newReloadTestModel(t)
+	if err := m.runCommand("goto 2:30pm"); err != nil {
+		t.Fatalf("goto failed: %v", err)
+	}
+	now := m.displayNow()
+	if m.inputMode != ModeTimeline || now.Hour() != 14 || now.Minute() != 30 {
+		t.Errorf("goto: mode %v, display time %s, want timeline at 14:30", m.inputMode, now.Format("15:04"))
+	}
+}
+
+func TestCommandCompletion(t *testing.T) {
+	tab := tea.KeyMsg{Type: tea.KeyTab}
+	m, _ := newReloadTestModel(t)
+	m.config.Colleagues[0].Tags = []string{"backend"}
+
+	tests := []struct {
+		typed string
+		want  string
+	}{
+		{"sc", "scheme "},
+		{"sort o", "sort offset"},
+		{"filter tag:b", "filter tag:backend"},
+		{"mode x", "mode x"}, // No match: unchanged
+	}
+	for _, tt := range tests {
+		t.Run(tt.typed, func(t *testing.T) {
+			m := typeCommand(t, m, tt.typed)
+			next, _ := m.handleCommandMode(tab)
+			m = next.(Model)
+			if got := m.nameInput.Value(); got != tt.want {
+				t.Errorf("completion of %q = %q, want %q", tt.typed, got, tt.want)
+			}
+		})
+	}
+
+	// Several matches extend to the common prefix and are listed
+	m = typeCommand(t, m, "mode ")
+	next, _ := m.handleCommandMode(tab)
+	m = next.(Model)
+	if got := m.nameInput.Value(); got != "mode " || !reflect.DeepEqual(m.commandHints, []string{"individual", "shared"}) {
+		t.Errorf("completion of %q = %q, hints %v", "mode ", got, m.commandHints)
+	}
+}
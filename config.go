@@ -15,6 +15,7 @@ func DefaultConfig() Config {
 		LocationDisplayFormat: "auto",
 		ColorScheme:           "classic",
 		TimelineMode:          "individual",
+		SortBy:                SortByConfig,
 		Colleagues: []Colleague{
 			{
 				Name:     "Alice (New York)",
@@ -41,6 +42,90 @@ func GetDefaultConfigPath() (string, error) {
 	return filepath.Join(homeDir, ".config", "tui-clock", "config.yaml"), nil
 }
 
+// Config discovery sources, reported by ResolveConfigPath
+const (
+	ConfigSourceFlag    = "-config flag"
+	ConfigSourceEnv     = "TUI_CLOCK_CONFIG"
+	ConfigSourceProject = "project file"
+	ConfigSourceXDG     = "XDG_CONFIG_HOME"
+	ConfigSourceDefault = "default location"
+)
+
+// ProjectConfigName is the per-project config file looked up from the
+// current directory upwards, so a repository can ship its team roster
+const ProjectConfigName = ".tui-clock.yaml"
+
+// ResolveConfigPath picks the config file to use when no -config flag
+// was given, and reports which rule chose it. In order:
+//
+//  1. $TUI_CLOCK_CONFIG
+//  2. .tui-clock.yaml in the current directory or any parent
+//  3. $XDG_CONFIG_HOME/tui-clock/config.yaml
+//  4. ~/.config/tui-clock/config.yaml
+//
+// Project files are only used if they exist. Between 3 and 4 an
+// existing file wins; if neither exists the XDG location (when set) is
+// where the default config gets created.
+func ResolveConfigPath() (string, string, error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		cwd = ""
+	}
+	return resolveConfigPath(os.Getenv, cwd, GetDefaultConfigPath)
+}
+
+// resolveConfigPath implements ResolveConfigPath with its environment
+// injected for tests
+func resolveConfigPath(getenv func(string) string, cwd string, defaultPath func() (string, error)) (string, string, error) {
+	if path := getenv("TUI_CLOCK_CONFIG"); path != "" {
+		return path, ConfigSourceEnv, nil
+	}
+
+	if cwd != "" {
+		if path, ok := findProjectConfig(cwd); ok {
+			return path, ConfigSourceProject, nil
+		}
+	}
+
+	var xdgPath string
+	if xdg := getenv("XDG_CONFIG_HOME"); xdg != "" && filepath.IsAbs(xdg) {
+		xdgPath = filepath.Join(xdg, "tui-clock", "config.yaml")
+		if fileExists(xdgPath) {
+			return xdgPath, ConfigSourceXDG, nil
+		}
+	}
+
+	home, err := defaultPath()
+	if err == nil && (fileExists(home) || xdgPath == "") {
+		return home, ConfigSourceDefault, nil
+	}
+	if xdgPath != "" {
+		return xdgPath, ConfigSourceXDG, nil
+	}
+	return "", "", err
+}
+
+// findProjectConfig walks up from dir looking for ProjectConfigName
+func findProjectConfig(dir string) (string, bool) {
+	for {
+		path := filepath.Join(dir, ProjectConfigName)
+		if fileExists(path) {
+			return path, true
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", false
+		}
+		dir = parent
+	}
+}
+
+// fileExists reports whether path exists and is a regular file
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.Mode().IsRegular()
+}
+
 // LoadConfig loads configuration from a YAML file
 func LoadConfig(path string) (Config, error) {
 	data, err := os.ReadFile(path)
@@ -78,14 +163,9 @@ func parseConfig(data []byte) (Config, error) {
 	// sentinel. A 0-0 range is empty and cannot be meant literally, so
 	// map it back to unset. Genuine midnight bounds (e.g. sleep 22-0)
 	// contain a non-zero value and are untouched.
-	for i := range config.Colleagues {
-		c := &config.Colleagues[i]
-		if c.SleepStart != nil && c.SleepEnd != nil && *c.SleepStart == 0 && *c.SleepEnd == 0 {
-			c.SleepStart, c.SleepEnd = nil, nil
-		}
-		if c.WorkStart != nil && c.WorkEnd != nil && *c.WorkStart == 0 && *c.WorkEnd == 0 {
-			c.WorkStart, c.WorkEnd = nil, nil
-		}
+	migrateLegacyHours(config.Colleagues)
+	for _, p := range config.Profiles {
+		migrateLegacyHours(p.Colleagues)
 	}
 
 	// Set default time format if not specified
@@ -108,9 +188,75 @@ func parseConfig(data []byte) (Config, error) {
 		config.TimelineMode = "individual"
 	}
 
+	// Set default sort order if not specified
+	if config.SortBy == "" {
+		config.SortBy = SortByConfig
+	}
+	if err := ValidateSortBy(config.SortBy); err != nil {
+		return Config{}, fmt.Errorf("invalid config: %w", err)
+	}
+
+	if err := ValidateTimelineHours(config.TimelineHours); err != nil {
+		return Config{}, fmt.Errorf("invalid config: %w", err)
+	}
+	if err := ValidateRowLabels(config.TimelineRowLabels); err != nil {
+		return Config{}, fmt.Errorf("invalid config: %w", err)
+	}
+	config.TimelineRowLabels = normalizeRowLabels(config.TimelineRowLabels)
+
+	if config.TrashRetentionDays < 0 {
+		return Config{}, fmt.Errorf("invalid config: trash_retention_days must not be negative, got %d", config.TrashRetentionDays)
+	}
+
+	if err := ValidateColumns(config.Columns); err != nil {
+		return Config{}, fmt.Errorf("invalid config: %w", err)
+	}
+
+	if err := validateConfigWorkdays(config); err != nil {
+		return Config{}, fmt.Errorf("invalid config: %w", err)
+	}
+
+	// Reject broken custom color schemes up front: at startup this
+	// reports the problem, and on hot-reload the edit is skipped like
+	// any other invalid file
+	if _, err := buildCustomColorSchemes(config.ColorSchemes); err != nil {
+		return Config{}, fmt.Errorf("invalid config: %w", err)
+	}
+
+	if config.LocalTimezone != "" {
+		if err := ValidateTimezone(config.LocalTimezone); err != nil {
+			return Config{}, fmt.Errorf("invalid config: local_timezone: %w", err)
+		}
+	}
+
+	// The top-level roster is the default profile; a named profile
+	// can't shadow it
+	if _, ok := config.Profiles[DefaultProfile]; ok {
+		return Config{}, fmt.Errorf("invalid config: profile name %q is reserved for the top-level colleagues", DefaultProfile)
+	}
+
+	// Likewise for unknown actions or conflicting key bindings
+	if _, err := NewKeyMap(config.Keys); err != nil {
+		return Config{}, fmt.Errorf("invalid config: %w", err)
+	}
+
 	return config, nil
 }
 
+// migrateLegacyHours maps the (0, 0) "use defaults" sentinel written
+// by older versions back to unset hours; see parseConfig
+func migrateLegacyHours(colleagues []Colleague) {
+	for i := range colleagues {
+		c := &colleagues[i]
+		if c.SleepStart != nil && c.SleepEnd != nil && *c.SleepStart == 0 && *c.SleepEnd == 0 {
+			c.SleepStart, c.SleepEnd = nil, nil
+		}
+		if c.WorkStart != nil && c.WorkEnd != nil && *c.WorkStart == 0 && *c.WorkEnd == 0 {
+			c.WorkStart, c.WorkEnd = nil, nil
+		}
+	}
+}
+
 // SaveConfig saves configuration to a YAML file
 func SaveConfig(path string, config Config) error {
 	// Create directory if it doesn't exist
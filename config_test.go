@@ -243,3 +243,63 @@ func TestLoadConfigInvalid(t *testing.T) {
 		t.Error("Expected error when loading invalid config, got nil")
 	}
 }
+
+func TestResolveConfigPath(t *testing.T) {
+	root := t.TempDir()
+	home := filepath.Join(root, "home", ".config", "tui-clock", "config.yaml")
+	xdg := filepath.Join(root, "xdg")
+	xdgPath := filepath.Join(xdg, "tui-clock", "config.yaml")
+	project := filepath.Join(root, "repo")
+	nested := filepath.Join(project, "src", "pkg")
+	if err := os.MkdirAll(nested, 0755); err != nil {
+		t.Fatalf("MkdirAll failed: %v", err)
+	}
+	defaultPath := func() (string, error) { return home, nil }
+
+	env := func(vars map[string]string) func(string) string {
+		return func(key string) string { return vars[key] }
+	}
+	touch := func(path string) {
+		t.Helper()
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("MkdirAll failed: %v", err)
+		}
+		if err := os.WriteFile(path, []byte("colleagues: []\n"), 0644); err != nil {
+			t.Fatalf("WriteFile failed: %v", err)
+		}
+	}
+
+	check := func(name string, getenv func(string) string, cwd, wantPath, wantSource string) {
+		t.Helper()
+		path, source, err := resolveConfigPath(getenv, cwd, defaultPath)
+		if err != nil {
+			t.Fatalf("%s: resolveConfigPath failed: %v", name, err)
+		}
+		if path != wantPath || source != wantSource {
+			t.Errorf("%s: got (%q, %q), want (%q, %q)", name, path, source, wantPath, wantSource)
+		}
+	}
+
+	// Nothing exists: without XDG the home default is used (and created later)
+	check("no files", env(nil), nested, home, ConfigSourceDefault)
+	// Nothing exists: with XDG set, defaults are created there
+	check("xdg fresh", env(map[string]string{"XDG_CONFIG_HOME": xdg}), nested, xdgPath, ConfigSourceXDG)
+	// A relative XDG_CONFIG_HOME is invalid per the spec and ignored
+	check("xdg relative", env(map[string]string{"XDG_CONFIG_HOME": "rel"}), nested, home, ConfigSourceDefault)
+
+	// An existing home config wins over a missing XDG one
+	touch(home)
+	check("home exists", env(map[string]string{"XDG_CONFIG_HOME": xdg}), nested, home, ConfigSourceDefault)
+	// An existing XDG config wins over the home one
+	touch(xdgPath)
+	check("xdg exists", env(map[string]string{"XDG_CONFIG_HOME": xdg}), nested, xdgPath, ConfigSourceXDG)
+
+	// A project file in a parent directory beats both
+	projectPath := filepath.Join(project, ProjectConfigName)
+	touch(projectPath)
+	check("project parent", env(map[string]string{"XDG_CONFIG_HOME": xdg}), nested, projectPath, ConfigSourceProject)
+
+	// The environment variable beats everything, existing or not
+	explicit := filepath.Join(root, "elsewhere.yaml")
+	check("env", env(map[string]string{"TUI_CLOCK_CONFIG": explicit, "XDG_CONFIG_HOME": xdg}), nested, explicit, ConfigSourceEnv)
+}
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"gopkg.in/yaml.v3"
+)
+
+// ColorValue is a color as written in the config: either a single
+// color (hex like "#00d7ff" or an ANSI 256 index like "86"), or an
+// adaptive light/dark pair picked by the terminal background.
+type ColorValue struct {
+	Color string // Single color; empty when Light/Dark are used
+	Light string
+	Dark  string
+}
+
+// UnmarshalYAML accepts a scalar ("#ff0000", 196) or a mapping with
+// light and dark keys
+func (c *ColorValue) UnmarshalYAML(node *yaml.Node) error {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		*c = ColorValue{Color: node.Value}
+		return nil
+	case yaml.MappingNode:
+		var pair struct {
+			Light string `yaml:"light"`
+			Dark  string `yaml:"dark"`
+		}
+		if err := node.Decode(&pair); err != nil {
+			return err
+		}
+		*c = ColorValue{Light: pair.Light, Dark: pair.Dark}
+		return nil
+	default:
+		return fmt.Errorf("line %d: color must be a string or a {light, dark} mapping", node.Line)
+	}
+}
+
+// MarshalYAML writes the value back in the form it was read
+func (c ColorValue) MarshalYAML() (any, error) {
+	if c.Color != "" {
+		return c.Color, nil
+	}
+	return map[string]string{"light": c.Light, "dark": c.Dark}, nil
+}
+
+// terminalColor converts the value into a lipgloss color, validating
+// each component
+func (c ColorValue) terminalColor() (lipgloss.TerminalColor, error) {
+	if c.Color != "" {
+		return parseColor(c.Color)
+	}
+	if c.Light == "" || c.Dark == "" {
+		return nil, fmt.Errorf("adaptive color needs both light and dark")
+	}
+	if _, err := parseColor(c.Light); err != nil {
+		return nil, fmt.Errorf("light: %w", err)
+	}
+	if _, err := parseColor(c.Dark); err != nil {
+		return nil, fmt.Errorf("dark: %w", err)
+	}
+	return lipgloss.AdaptiveColor{Light: c.Light, Dark: c.Dark}, nil
+}
+
+var hexColorPattern = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// parseColor validates a single color: "#rgb", "#rrggbb" or an ANSI
+// 256 index (0-255)
+func parseColor(s string) (lipgloss.Color, error) {
+	s = strings.TrimSpace(s)
+	if hexColorPattern.MatchString(s) {
+		return lipgloss.Color(s), nil
+	}
+	if n, err := strconv.Atoi(s); err == nil && n >= 0 && n <= 255 {
+		return lipgloss.Color(s), nil
+	}
+	return "", fmt.Errorf("invalid color %q (want #rrggbb, #rgb or 0-255)", s)
+}
+
+// CustomColorScheme is a user-defined scheme from the config's
+// color_schemes section. Unset fields are taken from the built-in
+// scheme named by Inherits; without Inherits every field is required.
+type CustomColorScheme struct {
+	Inherits  string      `yaml:"inherits,omitempty"` // Built-in scheme to start from
+	Sleep     *ColorValue `yaml:"sleep,omitempty"`
+	AwakeOff  *ColorValue `yaml:"awake_off,omitempty"`
+	Work      *ColorValue `yaml:"work,omitempty"`
+	Marker    *ColorValue `yaml:"marker,omitempty"`
+	Weekend   *ColorValue `yaml:"weekend,omitempty"`
+	Primary   *ColorValue `yaml:"primary,omitempty"`
+	Secondary *ColorValue `yaml:"secondary,omitempty"`
+	Success   *ColorValue `yaml:"success,omitempty"`
+	Warning   *ColorValue `yaml:"warning,omitempty"`
+	Error     *ColorValue `yaml:"error,omitempty"`
+	Muted     *ColorValue `yaml:"muted,omitempty"`
+}
+
+// colorSchemeFieldKeys maps ColorScheme field names (as reported by
+// ValidateColorScheme) to their config keys, so errors name what the
+// user has to write
+var colorSchemeFieldKeys = map[string]string{
+	"SleepColor":    "sleep",
+	"AwakeOffColor": "awake_off",
+	"WorkColor":     "work",
+	"MarkerColor":   "marker",
+	"WeekendTint":   "weekend",
+	"Primary":       "primary",
+	"Secondary":     "secondary",
+	"Success":       "success",
+	"Warning":       "warning",
+	"Error":         "error",
+	"Muted":         "muted",
+}
+
+// buildColorScheme resolves a user-defined scheme into a ColorScheme:
+// start from the inherited built-in (if any), overlay the configured
+// colors, then check nothing is left unset
+func buildColorScheme(name string, custom CustomColorScheme) (ColorScheme, error) {
+	if _, builtin := colorSchemes[name]; builtin {
+		return ColorScheme{}, fmt.Errorf("color scheme %q conflicts with a built-in scheme (use inherits: %s to extend it)", name, name)
+	}
+
+	var scheme ColorScheme
+	if custom.Inherits != "" {
+		base, ok := colorSchemes[custom.Inherits]
+		if !ok {
+			return ColorScheme{}, fmt.Errorf("color scheme %q inherits unknown built-in scheme %q", name, custom.Inherits)
+		}
+		scheme = base
+	}
+	scheme.Name = name
+
+	fields := []struct {
+		key    string
+		value  *ColorValue
+		target *lipgloss.TerminalColor
+	}{
+		{"sleep", custom.Sleep, &scheme.SleepColor},
+		{"awake_off", custom.AwakeOff, &scheme.AwakeOffColor},
+		{"work", custom.Work, &scheme.WorkColor},
+		{"marker", custom.Marker, &scheme.MarkerColor},
+		{"weekend", custom.Weekend, &scheme.WeekendTint},
+		{"primary", custom.Primary, &scheme.Primary},
+		{"secondary", custom.Secondary, &scheme.Secondary},
+		{"success", custom.Success, &scheme.Success},
+		{"warning", custom.Warning, &scheme.Warning},
+		{"error", custom.Error, &scheme.Error},
+		{"muted", custom.Muted, &scheme.Muted},
+	}
+	for _, f := range fields {
+		if f.value == nil {
+			continue
+		}
+		color, err := f.value.terminalColor()
+		if err != nil {
+			return ColorScheme{}, fmt.Errorf("color scheme %q %s: %w", name, f.key, err)
+		}
+		*f.target = color
+	}
+
+	if missing := ValidateColorScheme(scheme); len(missing) > 0 {
+		keys := make([]string, len(missing))
+		for i, field := range missing {
+			keys[i] = colorSchemeFieldKeys[field]
+		}
+		return ColorScheme{}, fmt.Errorf("color scheme %q is missing %s (set them or add inherits: <built-in>)",
+			name, strings.Join(keys, ", "))
+	}
+
+	return scheme, nil
+}
+
+// buildCustomColorSchemes resolves every user-defined scheme, failing
+// on the first invalid one (in name order, so errors are stable)
+func buildCustomColorSchemes(customs map[string]CustomColorScheme) (map[string]ColorScheme, error) {
+	names := make([]string, 0, len(customs))
+	for name := range customs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	schemes := make(map[string]ColorScheme, len(customs))
+	for _, name := range names {
+		scheme, err := buildColorScheme(name, customs[name])
+		if err != nil {
+			return nil, err
+		}
+		schemes[name] = scheme
+	}
+	return schemes, nil
+}
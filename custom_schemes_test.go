@@ -0,0 +1,220 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"gopkg.in/yaml.v3"
+)
+
+func TestParseColor(t *testing.T) {
+	tests := []struct {
+		input   string
+		wantErr bool
+	}{
+		{"#00d7ff", false},
+		{"#FFF", false},
+		{"86", false},
+		{"0", false},
+		{"255", false},
+		{"256", true},
+		{"-1", true},
+		{"#12345", true},
+		{"cyan", true},
+		{"", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := parseColor(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseColor(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestColorValueYAML(t *testing.T) {
+	input := `
+plain: "#ff0000"
+ansi: 86
+adaptive: {light: "#eeeeee", dark: "#111111"}
+`
+	var values map[string]ColorValue
+	if err := yaml.Unmarshal([]byte(input), &values); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+
+	if values["plain"].Color != "#ff0000" {
+		t.Errorf("plain = %+v, want Color #ff0000", values["plain"])
+	}
+	if values["ansi"].Color != "86" {
+		t.Errorf("ansi = %+v, want Color 86", values["ansi"])
+	}
+	if values["adaptive"].Light != "#eeeeee" || values["adaptive"].Dark != "#111111" {
+		t.Errorf("adaptive = %+v, want light/dark pair", values["adaptive"])
+	}
+
+	color, err := values["adaptive"].terminalColor()
+	if err != nil {
+		t.Fatalf("terminalColor failed: %v", err)
+	}
+	if _, ok := color.(lipgloss.AdaptiveColor); !ok {
+		t.Errorf("adaptive terminalColor = %T, want lipgloss.AdaptiveColor", color)
+	}
+
+	// Round-trip keeps the original form
+	out, err := yaml.Marshal(values)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	var again map[string]ColorValue
+	if err := yaml.Unmarshal(out, &again); err != nil {
+		t.Fatalf("Re-unmarshal failed: %v", err)
+	}
+	for key, v := range values {
+		if again[key] != v {
+			t.Errorf("round-trip %s = %+v, want %+v", key, again[key], v)
+		}
+	}
+}
+
+func TestBuildColorScheme(t *testing.T) {
+	t.Run("inherits fills unset fields", func(t *testing.T) {
+		scheme, err := buildColorScheme("ocean", CustomColorScheme{
+			Inherits: "nord",
+			Work:     &ColorValue{Color: "#2aa198"},
+		})
+		if err != nil {
+			t.Fatalf("buildColorScheme failed: %v", err)
+		}
+		if scheme.Name != "ocean" {
+			t.Errorf("Name = %q, want ocean", scheme.Name)
+		}
+		if scheme.WorkColor != lipgloss.Color("#2aa198") {
+			t.Errorf("WorkColor = %v, want override", scheme.WorkColor)
+		}
+		if scheme.MarkerColor != nordScheme.MarkerColor {
+			t.Errorf("MarkerColor = %v, want inherited nord value", scheme.MarkerColor)
+		}
+	})
+
+	t.Run("missing fields are reported by config key", func(t *testing.T) {
+		_, err := buildColorScheme("partial", CustomColorScheme{
+			Sleep: &ColorValue{Color: "0"},
+			Work:  &ColorValue{Color: "10"},
+		})
+		if err == nil {
+			t.Fatal("Expected error for incomplete scheme without inherits")
+		}
+		for _, key := range []string{"awake_off", "marker", "muted"} {
+			if !strings.Contains(err.Error(), key) {
+				t.Errorf("error %q should name missing field %q", err, key)
+			}
+		}
+		if strings.Contains(err.Error(), "work") {
+			t.Errorf("error %q names a field that was set", err)
+		}
+	})
+
+	t.Run("invalid color", func(t *testing.T) {
+		_, err := buildColorScheme("bad", CustomColorScheme{
+			Inherits: "classic",
+			Marker:   &ColorValue{Color: "not-a-color"},
+		})
+		if err == nil || !strings.Contains(err.Error(), "marker") {
+			t.Errorf("Expected marker color error, got %v", err)
+		}
+	})
+
+	t.Run("unknown inherits", func(t *testing.T) {
+		if _, err := buildColorScheme("x", CustomColorScheme{Inherits: "nope"}); err == nil {
+			t.Error("Expected error for unknown inherited scheme")
+		}
+	})
+
+	t.Run("built-in names are reserved", func(t *testing.T) {
+		if _, err := buildColorScheme("nord", CustomColorScheme{Inherits: "nord"}); err == nil {
+			t.Error("Expected error when redefining a built-in scheme")
+		}
+	})
+}
+
+func TestCustomColorSchemesCycle(t *testing.T) {
+	t.Cleanup(func() { setCustomColorSchemes(nil) })
+
+	schemes, err := buildCustomColorSchemes(map[string]CustomColorScheme{
+		"ocean": {Inherits: "nord"},
+	})
+	if err != nil {
+		t.Fatalf("buildCustomColorSchemes failed: %v", err)
+	}
+	setCustomColorSchemes(schemes)
+
+	available := GetAvailableColorSchemes()
+	if len(available) != 6 {
+		t.Fatalf("GetAvailableColorSchemes() = %v, want 5 built-ins plus ocean", available)
+	}
+	if next := GetNextColorScheme("nord"); next != "ocean" {
+		t.Errorf("GetNextColorScheme(nord) = %q, want ocean", next)
+	}
+	if next := GetNextColorScheme("ocean"); next != "solarized" {
+		t.Errorf("GetNextColorScheme(ocean) = %q, want solarized", next)
+	}
+	if got := getCurrentColorScheme("ocean"); got.Name != "ocean" {
+		t.Errorf("getCurrentColorScheme(ocean).Name = %q", got.Name)
+	}
+
+	// Replacing the set drops schemes removed from the config
+	setCustomColorSchemes(nil)
+	if got := getCurrentColorScheme("ocean"); got.Name != "classic" {
+		t.Errorf("Removed scheme should fall back to classic, got %q", got.Name)
+	}
+}
+
+func TestLoadConfigCustomColorSchemes(t *testing.T) {
+	t.Cleanup(func() {
+		setCustomColorSchemes(nil)
+		applyUIColorScheme(classicScheme)
+	})
+	path := filepath.Join(t.TempDir(), "config.yaml")
+
+	valid := `color_scheme: ocean
+color_schemes:
+  ocean:
+    inherits: nord
+    work: "#2aa198"
+    sleep: {light: "#eeeeee", dark: "#101010"}
+colleagues: []
+`
+	if err := os.WriteFile(path, []byte(valid), 0644); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+	config, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig failed: %v", err)
+	}
+
+	// The model registers the scheme and applies it to the UI styles
+	m := NewModel(config, path)
+	if got := getCurrentColorScheme(m.config.ColorScheme); got.Name != "ocean" {
+		t.Errorf("Active scheme = %q, want ocean", got.Name)
+	}
+	if got := workingStyle.GetForeground(); got != lipgloss.TerminalColor(nordScheme.Success) {
+		t.Errorf("workingStyle foreground = %v, want inherited Success %v", got, nordScheme.Success)
+	}
+
+	invalid := `color_schemes:
+  broken:
+    work: "#00ff00"
+`
+	if err := os.WriteFile(path, []byte(invalid), 0644); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+	if _, err := LoadConfig(path); err == nil {
+		t.Error("Expected LoadConfig to reject a scheme with missing fields")
+	}
+}
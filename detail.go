@@ -0,0 +1,82 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DetailDSTHorizon is how far ahead the detail pane looks for offset
+// changes (the list's ⚡ warning only covers DSTLookahead)
+const DetailDSTHorizon = 366 * 24 * time.Hour
+
+// detailTarget returns the colleague the detail pane describes: the
+// cursor row, even while its highlight is auto-hidden
+func (m Model) detailTarget() (ColleagueTime, bool) {
+	if !m.showDetail || m.cursor < 0 || m.cursor >= len(m.colleagues) {
+		return ColleagueTime{}, false
+	}
+	return m.colleagues[m.cursor], true
+}
+
+// renderDetail renders the detail pane for the selected colleague, or
+// "" when the pane is closed or nothing is selected
+func (m Model) renderDetail() string {
+	ct, ok := m.detailTarget()
+	if !ok {
+		return ""
+	}
+	c := ct.Colleague
+
+	var b strings.Builder
+	b.WriteString(promptStyle.Render("── " + c.Name + " ──"))
+	b.WriteString("\n")
+
+	if ct.InvalidTimezone {
+		b.WriteString(detailLine("Zone", invalidStyle.Render(fmt.Sprintf("invalid timezone %q", c.Timezone))))
+		return b.String()
+	}
+
+	b.WriteString(detailLine("Zone", fmt.Sprintf("%s (%s, %s)",
+		c.Timezone, ct.CurrentTime.Format("MST"), formatUTCOffset(ct.CurrentTime))))
+	work := hourRangeLabel(c.WorkStart, c.WorkEnd, c.GetWorkStart(), c.GetWorkEnd())
+	if countdown := workCountdown(ct); countdown != "" {
+		work += " · " + countdown
+	}
+	b.WriteString(detailLine("Work", work))
+	b.WriteString(detailLine("Sleep",
+		hourRangeLabel(c.SleepStart, c.SleepEnd, c.GetSleepStart(), c.GetSleepEnd())))
+
+	dst := "no offset changes in the next year"
+	if changes := upcomingOffsetChanges(ct.CurrentTime.Location(), ct.CurrentTime, DetailDSTHorizon, 2); len(changes) > 0 {
+		parts := make([]string, len(changes))
+		for i, ch := range changes {
+			parts[i] = fmt.Sprintf("%s %d", formatDSTWarning(ch.DeltaHours, ch.At), ch.At.Year())
+		}
+		dst = strings.Join(parts, ", ")
+	}
+	b.WriteString(detailLine("DST", dst))
+
+	// Their day at a glance, with hour labels under the bar
+	barWidth := m.calculateTimelineBarWidth()
+	b.WriteString("  ")
+	b.WriteString(m.renderIndividualBar(ct, barWidth))
+	b.WriteString("\n")
+	b.WriteString(m.renderHourLabels(m.windowAt(ct.CurrentTime), barWidth, 2, m.config.CentersTimeline()))
+	return b.String()
+}
+
+// detailLine renders one labelled line of the detail pane
+func detailLine(label, value string) string {
+	return fmt.Sprintf("  %s %s\n", dateStyle.Render(fmt.Sprintf("%-6s", label)), value)
+}
+
+// hourRangeLabel formats an hour range, marking built-in defaults
+// (unset config fields) as such
+func hourRangeLabel(start, end *int, effStart, effEnd int) string {
+	label := fmt.Sprintf("%d-%d", effStart, effEnd)
+	if start == nil && end == nil {
+		label += " (default)"
+	}
+	return label
+}
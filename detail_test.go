@@ -0,0 +1,60 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestDetailPaneToggle(t *testing.T) {
+	m, _ := newReloadTestModel(t) // Alice (New York), Bob (London), Charlie (Tokyo)
+
+	// With nothing selected, enter opens the pane on the first row
+	m.cursor = -1
+	next, _ := m.handleNormalMode(tea.KeyMsg{Type: tea.KeyEnter})
+	m = next.(Model)
+	if !m.showDetail || m.cursor != 0 {
+		t.Fatalf("Expected detail pane on row 0, got showDetail %v cursor %d", m.showDetail, m.cursor)
+	}
+
+	detail := stripANSI(m.renderDetail())
+	for _, want := range []string{"Alice (New York)", "America/New_York", "9-17 (default)", " in "} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("detail pane missing %q:\n%s", want, detail)
+		}
+	}
+
+	next, _ = m.handleNormalMode(tea.KeyMsg{Type: tea.KeyEnter})
+	m = next.(Model)
+	if m.showDetail || m.renderDetail() != "" {
+		t.Error("Expected enter to close the detail pane")
+	}
+}
+
+func TestUpcomingOffsetChanges(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		zone string
+		want int
+	}{
+		{"Europe/London", 2}, // Spring forward and fall back
+		{"Asia/Tokyo", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.zone, func(t *testing.T) {
+			loc, err := time.LoadLocation(tt.zone)
+			if err != nil {
+				t.Fatalf("LoadLocation failed: %v", err)
+			}
+			changes := upcomingOffsetChanges(loc, from, DetailDSTHorizon, 2)
+			if len(changes) != tt.want {
+				t.Fatalf("upcomingOffsetChanges() = %+v, want %d changes", changes, tt.want)
+			}
+			if tt.want == 2 && (changes[0].DeltaHours != 1 || changes[1].DeltaHours != -1) {
+				t.Errorf("deltas = %v, %v, want 1, -1", changes[0].DeltaHours, changes[1].DeltaHours)
+			}
+		})
+	}
+}
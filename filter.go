@@ -0,0 +1,131 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+)
+
+// newFilterInput creates the input for the '/' filter prompt,
+// pre-filled with the active filter so it can be refined
+func newFilterInput(value string) textinput.Model {
+	input := textinput.New()
+	input.Placeholder = "name, city, country or timezone"
+	input.CharLimit = 50
+	input.Width = 40
+	input.Prompt = ""
+	input.SetValue(value)
+	return input
+}
+
+// colleagueMatchesFilter reports whether a colleague matches a
+// lowercased filter query: by name, timezone or tag, or by the city,
+// country or abbreviation of any known city in the colleague's zone
+// (the same fields timezone search scores with scoreMatch). A
+// "tag:" query matches only tags starting with the rest.
+func colleagueMatchesFilter(c Colleague, queryLower string) bool {
+	if tag, ok := strings.CutPrefix(queryLower, "tag:"); ok {
+		for _, t := range c.Tags {
+			if strings.HasPrefix(strings.ToLower(t), tag) {
+				return true
+			}
+		}
+		return false
+	}
+	if strings.Contains(strings.ToLower(c.Name), queryLower) ||
+		strings.Contains(strings.ToLower(c.Timezone), queryLower) {
+		return true
+	}
+	for _, tag := range c.Tags {
+		if strings.Contains(strings.ToLower(tag), queryLower) {
+			return true
+		}
+	}
+	for _, city := range AllCities {
+		if city.Timezone == c.Timezone && scoreMatch(city, queryLower) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// filterColleagueTimes keeps the rows matching query, preserving order
+// and ConfigIndex. An empty query keeps everything.
+func filterColleagueTimes(cts []ColleagueTime, query string) []ColleagueTime {
+	queryLower := strings.ToLower(strings.TrimSpace(query))
+	if queryLower == "" {
+		return cts
+	}
+	filtered := cts[:0]
+	for _, ct := range cts {
+		if colleagueMatchesFilter(ct.Colleague, queryLower) {
+			filtered = append(filtered, ct)
+		}
+	}
+	return filtered
+}
+
+// openFilter shows the filter prompt over the current view; it
+// returns to that view when closed
+func (m *Model) openFilter() {
+	m.returnMode = m.inputMode
+	m.inputMode = ModeFilter
+	m.nameInput = newFilterInput(m.filterQuery)
+	m.nameInput.Focus()
+	m.errorMsg = ""
+}
+
+// setFilter narrows the displayed colleagues to those matching query.
+// Display indices change: the selection stays on the same colleague if
+// it is still shown, otherwise moves to the first match.
+func (m *Model) setFilter(query string) {
+	selected := -1
+	if m.cursor >= 0 && m.cursor < len(m.colleagues) {
+		selected = m.colleagues[m.cursor].ConfigIndex
+	}
+
+	m.filterQuery = strings.TrimSpace(query)
+	m.updateColleagueTimes()
+
+	m.cursor = -1
+	for i, ct := range m.colleagues {
+		if ct.ConfigIndex == selected {
+			m.cursor = i
+		}
+	}
+	if m.cursor == -1 && len(m.colleagues) > 0 && m.viewMode() == ModeNormal {
+		m.cursor = 0
+		m.activateSelection()
+	}
+	m.scrollOffset = 0
+	m.scrollToCursor()
+}
+
+// viewMode is the mode whose screen is shown: the filter prompt and
+// command line are drawn over the view they were opened from
+func (m Model) viewMode() InputMode {
+	switch m.inputMode {
+	case ModeFilter, ModeCommand, ModeGoto, ModeRange:
+		return m.returnMode
+	}
+	return m.inputMode
+}
+
+// filterLabel returns the header tag for an active filter, or ""
+func (m Model) filterLabel() string {
+	if m.filterQuery == "" {
+		return ""
+	}
+	return fmt.Sprintf("  🔍 %q %d/%d", m.filterQuery, len(m.colleagues), len(m.config.Colleagues))
+}
+
+// renderFilterPrompt renders the filter input shown in place of the footer
+func (m Model) renderFilterPrompt() string {
+	var b strings.Builder
+	b.WriteString(promptStyle.Render("Filter: "))
+	b.WriteString(m.nameInput.View())
+	b.WriteString("\n")
+	b.WriteString(footerStyle.Render("Type to narrow • Enter keep filter • Esc clear"))
+	return b.String()
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestColleagueMatchesFilter(t *testing.T) {
+	c := Colleague{Name: "Charlie", Timezone: "Asia/Tokyo"}
+	tests := []struct {
+		query string
+		want  bool
+	}{
+		{"char", true},  // Name
+		{"asia/", true}, // IANA name
+		{"tokyo", true}, // City
+		{"japan", true}, // Country
+		{"jst", true},   // Abbreviation
+		{"london", false},
+		{"tag:back", false}, // No tags
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			if got := colleagueMatchesFilter(c, tt.query); got != tt.want {
+				t.Errorf("colleagueMatchesFilter(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterNarrowsListAndKeepsConfigIndex(t *testing.T) {
+	m, path := newReloadTestModel(t) // Alice (New York), Bob (London), Charlie (Tokyo)
+
+	next, _ := m.handleNormalMode(keyMsg("/"))
+	m = next.(Model)
+	if m.inputMode != ModeFilter {
+		t.Fatalf("Expected filter mode, got %v", m.inputMode)
+	}
+	for _, r := range "japan" {
+		next, _ = m.handleFilterMode(keyMsg(string(r)))
+		m = next.(Model)
+	}
+	if len(m.colleagues) != 1 || m.colleagues[0].ConfigIndex != 2 {
+		t.Fatalf("Expected only Charlie (config index 2), got %+v", m.colleagues)
+	}
+	next, _ = m.handleFilterMode(tea.KeyMsg{Type: tea.KeyEnter})
+	m = next.(Model)
+	if m.inputMode != ModeNormal || m.filterQuery != "japan" {
+		t.Fatalf("Enter should keep the filter: mode %v query %q", m.inputMode, m.filterQuery)
+	}
+
+	// Delete resolves through ConfigIndex, not the filtered row
+	next, _ = m.handleNormalMode(keyMsg("d"))
+	m = next.(Model)
+	next, _ = m.handleConfirmDeleteMode(keyMsg("y"))
+	m = next.(Model)
+	loaded, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig failed: %v", err)
+	}
+	if len(loaded.Colleagues) != 2 || loaded.Colleagues[0].Name != "Alice (New York)" || loaded.Colleagues[1].Name != "Bob (London)" {
+		t.Errorf("Expected Charlie deleted, got %+v", loaded.Colleagues)
+	}
+
+	// Esc clears the filter before it quits
+	next, cmd := m.handleNormalMode(tea.KeyMsg{Type: tea.KeyEsc})
+	m = next.(Model)
+	if cmd != nil || m.filterQuery != "" || len(m.colleagues) != 2 {
+		t.Errorf("Esc should clear the filter: query %q, %d rows", m.filterQuery, len(m.colleagues))
+	}
+}
+
+func TestFilterInTimelineMode(t *testing.T) {
+	m, _ := newReloadTestModel(t)
+	m.inputMode = ModeTimeline
+
+	next, _ := m.handleTimelineMode(keyMsg("/"))
+	m = next.(Model)
+	next, _ = m.handleFilterMode(keyMsg("lon"))
+	m = next.(Model)
+	if m.viewMode() != ModeTimeline || len(m.colleagues) != 1 {
+		t.Fatalf("Expected timeline filtered to London: view %v, %d rows", m.viewMode(), len(m.colleagues))
+	}
+
+	next, _ = m.handleFilterMode(tea.KeyMsg{Type: tea.KeyEsc})
+	m = next.(Model)
+	if m.inputMode != ModeTimeline || m.filterQuery != "" || len(m.colleagues) != 3 {
+		t.Errorf("Esc should clear the filter and return: mode %v query %q", m.inputMode, m.filterQuery)
+	}
+}
+
+func TestTagFilter(t *testing.T) {
+	c := Colleague{Name: "Dana", Timezone: "Europe/Berlin", Tags: []string{"Backend"}}
+	for query, want := range map[string]bool{"tag:back": true, "tag:backend": true, "tag:end": false, "tag:": true} {
+		if got := colleagueMatchesFilter(c, query); got != want {
+			t.Errorf("colleagueMatchesFilter(%q) = %v, want %v", query, got, want)
+		}
+	}
+}
@@ -0,0 +1,238 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Scrub step sizes for the timeline's arrow keys and their modifiers
+const (
+	ScrubStep     = time.Hour
+	ScrubFineStep = 15 * time.Minute
+)
+
+// shiftScrub moves the scrub position: f maps the displayed instant
+// (in the reference zone) to the new one. now is read once so the
+// offset is exact.
+func (m *Model) shiftScrub(f func(time.Time) time.Time) {
+	now := time.Now().In(m.referenceTimezone())
+	m.timeOffset = f(now.Add(m.timeOffset)).Sub(now)
+}
+
+// scrubDays moves the scrub position by whole calendar days in the
+// reference zone, so a DST change doesn't shift the time of day
+func (m *Model) scrubDays(days int) {
+	m.shiftScrub(func(t time.Time) time.Time { return t.AddDate(0, 0, days) })
+}
+
+// scrubToDayBoundary jumps to the next midnight (forward) or to the
+// current day's midnight, or the one before if already there (back)
+func (m *Model) scrubToDayBoundary(forward bool) {
+	m.shiftScrub(func(t time.Time) time.Time {
+		midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+		switch {
+		case forward:
+			return midnight.AddDate(0, 0, 1)
+		case midnight.Equal(t.Truncate(time.Minute)):
+			return midnight.AddDate(0, 0, -1)
+		default:
+			return midnight
+		}
+	})
+}
+
+// handleScrubKey moves the scrub position if msg is one of the scrub
+// keys, shared by the timeline and the split view; it reports whether
+// it was
+func (m *Model) handleScrubKey(msg tea.KeyMsg) bool {
+	switch {
+	case key.Matches(msg, m.keys.ScrubBack):
+		m.timeOffset -= ScrubStep
+	case key.Matches(msg, m.keys.ScrubForward):
+		m.timeOffset += ScrubStep
+	case key.Matches(msg, m.keys.ScrubBackFine):
+		m.timeOffset -= ScrubFineStep
+	case key.Matches(msg, m.keys.ScrubForwardFine):
+		m.timeOffset += ScrubFineStep
+	case key.Matches(msg, m.keys.ScrubBackDay):
+		m.scrubDays(-1)
+	case key.Matches(msg, m.keys.ScrubForwardDay):
+		m.scrubDays(1)
+	case key.Matches(msg, m.keys.PrevDay):
+		m.scrubToDayBoundary(false)
+	case key.Matches(msg, m.keys.NextDay):
+		m.scrubToDayBoundary(true)
+	default:
+		return false
+	}
+	return true
+}
+
+// formatScrubOffset formats the scrub offset for the timeline header,
+// e.g. "+2h", "-45m", "+3d2h"
+func formatScrubOffset(d time.Duration) string {
+	if d < 0 {
+		return "-" + formatCountdown(-d)
+	}
+	return "+" + formatCountdown(d)
+}
+
+// newGotoInput creates the input for the timeline's go-to-time prompt
+func newGotoInput() textinput.Model {
+	input := textinput.New()
+	input.Placeholder = "14:00, tue 10:30, +3d, 2026-11-02 09:00 Europe/London"
+	input.CharLimit = 60
+	input.Width = 60
+	input.Prompt = ""
+	return input
+}
+
+// openGoto shows the go-to-time prompt over the timeline
+func (m *Model) openGoto() {
+	m.returnMode = m.inputMode
+	m.inputMode = ModeGoto
+	m.nameInput = newGotoInput()
+	m.nameInput.Focus()
+	m.errorMsg = ""
+}
+
+// renderGotoPrompt renders the go-to-time prompt shown in place of the
+// timeline footer
+func (m Model) renderGotoPrompt() string {
+	var b strings.Builder
+	b.WriteString(promptStyle.Render("Go to: "))
+	b.WriteString(m.nameInput.View())
+	b.WriteString("\n")
+	b.WriteString(footerStyle.Render("time [day|date] [zone], or +3d / -2h30m from now • Enter go • Esc cancel"))
+	return b.String()
+}
+
+// parseGotoTarget parses a go-to-time input relative to now:
+//
+//   - relative: "+3d", "-90m", "+1d2h30m" (from now; days are
+//     calendar days in loc, so a DST change keeps the time of day)
+//   - absolute: "[day] time [zone]", where day is today, tomorrow, a
+//     weekday (its next occurrence, today included) or a YYYY-MM-DD
+//     date; time is as for parseClockTime and may be left out after a
+//     day (midnight); zone is an IANA name, defaulting to loc
+func parseGotoTarget(input string, now time.Time, loc *time.Location) (time.Time, error) {
+	s := strings.TrimSpace(input)
+	if s == "" {
+		return time.Time{}, fmt.Errorf("enter a time, e.g. 14:00, tue 10:30 or +3d")
+	}
+	if s[0] == '+' || s[0] == '-' {
+		days, d, err := parseRelativeDuration(s)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return now.In(loc).AddDate(0, 0, days).Add(d), nil
+	}
+
+	fields := strings.Fields(s)
+	// Trailing zone
+	if last := fields[len(fields)-1]; strings.Contains(last, "/") || last == "UTC" {
+		zone, err := time.LoadLocation(last)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("unknown timezone %q", last)
+		}
+		loc = zone
+		fields = fields[:len(fields)-1]
+	}
+	if len(fields) == 0 {
+		return time.Time{}, fmt.Errorf("missing time before the zone")
+	}
+
+	// Leading day
+	local := now.In(loc)
+	day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	hasDay := true
+	switch first := strings.ToLower(fields[0]); {
+	case first == "today":
+	case first == "tomorrow":
+		day = day.AddDate(0, 0, 1)
+	case parseWeekday(first) >= 0:
+		ahead := (int(parseWeekday(first)) - int(day.Weekday()) + 7) % 7
+		day = day.AddDate(0, 0, ahead)
+	default:
+		if date, err := time.ParseInLocation("2006-01-02", first, loc); err == nil {
+			day = date
+		} else {
+			hasDay = false
+		}
+	}
+	if hasDay {
+		fields = fields[1:]
+	}
+
+	hour, minute := 0, 0
+	if len(fields) > 0 {
+		var err error
+		// "9:30 am" arrives as two fields
+		if hour, minute, err = parseClockTime(strings.Join(fields, "")); err != nil {
+			return time.Time{}, err
+		}
+	} else if !hasDay {
+		return time.Time{}, fmt.Errorf("enter a time, e.g. 14:00, tue 10:30 or +3d")
+	}
+	return time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, loc), nil
+}
+
+// parseWeekday returns the weekday for a full or three-letter English
+// name, or -1
+func parseWeekday(s string) time.Weekday {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		name := strings.ToLower(d.String())
+		if s == name || (len(s) >= 3 && strings.HasPrefix(name, s)) {
+			return d
+		}
+	}
+	return -1
+}
+
+// parseRelativeDuration parses a signed day/hour/minute duration such
+// as "+3d", "-90m" or "+1d2h30m" into its days, to be applied as
+// calendar days, and the hours and minutes
+func parseRelativeDuration(s string) (int, time.Duration, error) {
+	sign := 1
+	if s[0] == '-' {
+		sign = -1
+	}
+	rest := s[1:]
+	if rest == "" {
+		return 0, 0, fmt.Errorf("expected an amount after %q, e.g. +3d", s[:1])
+	}
+
+	days := 0
+	var total time.Duration
+	for rest != "" {
+		i := 0
+		for i < len(rest) && rest[i] >= '0' && rest[i] <= '9' {
+			i++
+		}
+		if i == 0 || i == len(rest) {
+			return 0, 0, fmt.Errorf("invalid relative time %q (use d, h and m, e.g. +1d2h)", s)
+		}
+		n, err := strconv.Atoi(rest[:i])
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid relative time %q", s)
+		}
+		switch rest[i] {
+		case 'd':
+			days += n
+		case 'h':
+			total += time.Duration(n) * time.Hour
+		case 'm':
+			total += time.Duration(n) * time.Minute
+		default:
+			return 0, 0, fmt.Errorf("invalid unit %q in %q (use d, h or m)", rest[i], s)
+		}
+		rest = rest[i+1:]
+	}
+	return sign * days, time.Duration(sign) * total, nil
+}
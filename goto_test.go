@@ -0,0 +1,169 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestParseGotoTarget(t *testing.T) {
+	utc := time.UTC
+	london, _ := time.LoadLocation("Europe/London")
+	now := time.Date(2024, 1, 5, 12, 0, 0, 0, utc) // Friday
+
+	tests := []struct {
+		input   string
+		want    time.Time
+		wantErr bool
+	}{
+		{"14:00", time.Date(2024, 1, 5, 14, 0, 0, 0, utc), false},
+		{"9:30 am", time.Date(2024, 1, 5, 9, 30, 0, 0, utc), false},
+		{"tomorrow 8am", time.Date(2024, 1, 6, 8, 0, 0, 0, utc), false},
+		{"tue 10:30", time.Date(2024, 1, 9, 10, 30, 0, 0, utc), false},
+		{"Friday 9:00", time.Date(2024, 1, 5, 9, 0, 0, 0, utc), false},
+		{"mon", time.Date(2024, 1, 8, 0, 0, 0, 0, utc), false},
+		{"2024-11-02 09:00 Europe/London", time.Date(2024, 11, 2, 9, 0, 0, 0, london), false},
+		{"17:00 UTC", time.Date(2024, 1, 5, 17, 0, 0, 0, utc), false},
+		{"+3d", now.Add(72 * time.Hour), false},
+		{"-90m", now.Add(-90 * time.Minute), false},
+		{"+1d2h30m", now.Add(26*time.Hour + 30*time.Minute), false},
+		{"", time.Time{}, true},
+		{"+", time.Time{}, true},
+		{"+3w", time.Time{}, true},
+		{"+3", time.Time{}, true},
+		{"25:00", time.Time{}, true},
+		{"noon", time.Time{}, true},
+		{"10:00 Mars/Olympus", time.Time{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := parseGotoTarget(tt.input, now, utc)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseGotoTarget(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && !got.Equal(tt.want) {
+				t.Errorf("parseGotoTarget(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+
+	// Days are calendar days: across London's 2026-10-25 fall-back
+	// "+3d" keeps the time of day, 73 hours later
+	if london == nil {
+		t.Skip("tzdata unavailable")
+	}
+	before := time.Date(2026, 10, 23, 12, 0, 0, 0, london)
+	for input, want := range map[string]time.Time{
+		"+3d":    time.Date(2026, 10, 26, 12, 0, 0, 0, london),
+		"+3d2h":  time.Date(2026, 10, 26, 14, 0, 0, 0, london),
+		"-1d30m": time.Date(2026, 10, 22, 11, 30, 0, 0, london),
+	} {
+		got, err := parseGotoTarget(input, before, london)
+		if err != nil || !got.Equal(want) {
+			t.Errorf("parseGotoTarget(%q) across DST = %v, %v; want %v", input, got, err, want)
+		}
+	}
+	if got, _ := parseGotoTarget("+3d", before, london); got.Sub(before) != 73*time.Hour {
+		t.Errorf("+3d across the fall-back is %v later, want 73h", got.Sub(before))
+	}
+}
+
+func TestFormatScrubOffset(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{2 * time.Hour, "+2h"},
+		{-45 * time.Minute, "-45m"},
+		{74 * time.Hour, "+3d2h"},
+	}
+	for _, tt := range tests {
+		if got := formatScrubOffset(tt.d); got != tt.want {
+			t.Errorf("formatScrubOffset(%v) = %q, want %q", tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestScrubToDayBoundary(t *testing.T) {
+	m, _ := newReloadTestModel(t)
+	m.inputMode = ModeTimeline
+	midnight := func() time.Time {
+		shown := m.displayNow().In(m.referenceTimezone())
+		return time.Date(shown.Year(), shown.Month(), shown.Day(), 0, 0, 0, 0, shown.Location())
+	}
+
+	m.scrubToDayBoundary(true)
+	first := midnight()
+	if got := m.displayNow().In(m.referenceTimezone()); got.Hour() != 0 || got.Minute() != 0 {
+		t.Fatalf("] scrubbed to %v, want midnight", got)
+	}
+
+	// Back from a midnight goes to the one before, not the same one
+	m.scrubToDayBoundary(false)
+	if got := midnight(); !got.Equal(first.AddDate(0, 0, -1)) {
+		t.Errorf("[ from midnight = %v, want %v", got, first.AddDate(0, 0, -1))
+	}
+}
+
+func TestTimelineScrubKeys(t *testing.T) {
+	m, _ := newReloadTestModel(t)
+	m.inputMode = ModeTimeline
+
+	tests := []struct {
+		msg  tea.KeyMsg
+		want time.Duration
+	}{
+		{tea.KeyMsg{Type: tea.KeyRight}, ScrubStep},
+		{tea.KeyMsg{Type: tea.KeyShiftRight}, ScrubStep + ScrubFineStep},
+		{tea.KeyMsg{Type: tea.KeyShiftLeft}, ScrubStep},
+		{tea.KeyMsg{Type: tea.KeyLeft}, 0},
+	}
+	for _, tt := range tests {
+		next, _ := m.handleTimelineMode(tt.msg)
+		m = next.(Model)
+		if m.timeOffset != tt.want {
+			t.Errorf("after %s timeOffset = %v, want %v", tt.msg, m.timeOffset, tt.want)
+		}
+	}
+
+	// A day step keeps the time of day in the reference zone
+	before := m.displayNow().In(m.referenceTimezone())
+	next, _ := m.handleTimelineMode(tea.KeyMsg{Type: tea.KeyRight, Alt: true})
+	m = next.(Model)
+	after := m.displayNow().In(m.referenceTimezone())
+	if after.Hour() != before.Hour() || after.YearDay() == before.YearDay() {
+		t.Errorf("alt+right moved %v to %v, want the same time a day later", before, after)
+	}
+}
+
+func TestGotoPrompt(t *testing.T) {
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+	m, _ := newReloadTestModel(t)
+	m.inputMode = ModeTimeline
+
+	next, _ := m.handleTimelineMode(keyMsg("g"))
+	m = next.(Model)
+	if m.inputMode != ModeGoto {
+		t.Fatalf("g: inputMode = %v, want ModeGoto", m.inputMode)
+	}
+
+	// A bad time keeps the prompt open with the error shown
+	next, _ = m.handleKeyPress(keyMsg("someday"))
+	m = next.(Model)
+	next, _ = m.handleKeyPress(enter)
+	m = next.(Model)
+	if m.inputMode != ModeGoto || m.errorMsg == "" {
+		t.Fatalf("bad time: inputMode = %v, errorMsg = %q, want the prompt with an error", m.inputMode, m.errorMsg)
+	}
+
+	m.nameInput.SetValue("+3d")
+	next, _ = m.handleKeyPress(enter)
+	m = next.(Model)
+	if m.inputMode != ModeTimeline || m.errorMsg != "" {
+		t.Fatalf("+3d: inputMode = %v, errorMsg = %q, want the timeline", m.inputMode, m.errorMsg)
+	}
+	if d := m.timeOffset - 72*time.Hour; d < -time.Second || d > time.Second {
+		t.Errorf("+3d: timeOffset = %v, want 72h", m.timeOffset)
+	}
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// MaxHistory is how many in-app config edits can be undone
+const MaxHistory = 50
+
+// StatusTimeout is how long a transient status message stays visible
+const StatusTimeout = 3 * time.Second
+
+// historyEntry is one undoable edit: the active profile's config as it
+// was on the other side of the edit, and what the edit was
+type historyEntry struct {
+	desc   string // e.g. "delete Bob"
+	config Config
+}
+
+// history holds the undo and redo stacks for in-app config edits.
+// Entries are whole-config snapshots of the active profile, restored
+// through the normal save path. Both stacks are dropped when the
+// config changes underneath them (hot reload, profile switch).
+//
+// A new edit's entry is pending until the save that follows it: a
+// failed save drops the entry again and rolls the edit back, so the
+// stack only holds edits that reached the file.
+type history struct {
+	undo []historyEntry
+	redo []historyEntry
+
+	pending     bool
+	pendingRedo []historyEntry // The redo stack the pending edit invalidated
+}
+
+// cloneConfig copies the parts of a config that in-app edits mutate
+// in place. Colleague hour pointers are replaced, never written
+// through, so copying the slices is enough.
+func cloneConfig(c Config) Config {
+	c.Colleagues = slices.Clone(c.Colleagues)
+	c.Trash = slices.Clone(c.Trash)
+	return c
+}
+
+// recordHistory snapshots the config before an edit described by
+// desc. Any new edit invalidates the redo stack. The entry is kept or
+// dropped by the saveConfig that follows the edit.
+func (m *Model) recordHistory(desc string) {
+	m.history.undo = append(m.history.undo, historyEntry{desc: desc, config: cloneConfig(m.config)})
+	m.history.pending = true
+	m.history.pendingRedo = m.history.redo
+	m.history.redo = nil
+}
+
+// commitHistory keeps the pending entry once its edit is saved
+func (m *Model) commitHistory() {
+	if !m.history.pending {
+		return
+	}
+	if len(m.history.undo) > MaxHistory {
+		m.history.undo = m.history.undo[len(m.history.undo)-MaxHistory:]
+	}
+	m.history.pending = false
+	m.history.pendingRedo = nil
+}
+
+// rollbackHistory undoes an edit whose save failed: the pending entry
+// is dropped, the config goes back to its snapshot and the redo stack
+// comes back
+func (m *Model) rollbackHistory() {
+	if !m.history.pending {
+		return
+	}
+	n := len(m.history.undo)
+	entry := m.history.undo[n-1]
+	m.history.undo = m.history.undo[:n-1]
+	m.history.redo = m.history.pendingRedo
+	m.history.pending = false
+	m.history.pendingRedo = nil
+
+	m.config = entry.config
+	m.applyColorScheme()
+	m.updateColleagueTimes()
+	m.clampScroll()
+}
+
+// clearHistory forgets all undo/redo steps
+func (m *Model) clearHistory() {
+	m.history = history{}
+}
+
+// undo reverts the most recent in-app edit and saves
+func (m *Model) undo() error {
+	n := len(m.history.undo)
+	if n == 0 {
+		m.setStatus("nothing to undo")
+		return nil
+	}
+	entry := m.history.undo[n-1]
+	m.history.undo = m.history.undo[:n-1]
+	m.history.redo = append(m.history.redo, historyEntry{desc: entry.desc, config: cloneConfig(m.config)})
+
+	m.restoreConfig(entry.config)
+	m.setStatus("undid: " + entry.desc)
+	return m.saveConfig()
+}
+
+// redo re-applies the most recently undone edit and saves
+func (m *Model) redo() error {
+	n := len(m.history.redo)
+	if n == 0 {
+		m.setStatus("nothing to redo")
+		return nil
+	}
+	entry := m.history.redo[n-1]
+	m.history.redo = m.history.redo[:n-1]
+	m.history.undo = append(m.history.undo, historyEntry{desc: entry.desc, config: cloneConfig(m.config)})
+
+	m.restoreConfig(entry.config)
+	m.setStatus("redid: " + entry.desc)
+	return m.saveConfig()
+}
+
+// restoreConfig swaps in a snapshot and refreshes everything derived
+// from it. The selection and marks are dropped: the snapshot may have a different
+// roster (same as after a delete).
+func (m *Model) restoreConfig(config Config) {
+	m.config = config
+	m.clearMarks()
+	m.applyColorScheme()
+	m.updateColleagueTimes()
+
+	m.cursor = -1
+	m.selectionActive = false
+	m.clampScroll()
+}
+
+// colleagueName returns a config colleague's name for history
+// descriptions
+func (m Model) colleagueName(index int) string {
+	if index >= 0 && index < len(m.config.Colleagues) {
+		return m.config.Colleagues[index].Name
+	}
+	return fmt.Sprintf("#%d", index+1)
+}
+
+// setStatus shows a transient message (cleared by the tick after
+// StatusTimeout)
+func (m *Model) setStatus(msg string) {
+	m.statusMsg = msg
+	m.statusAt = time.Now()
+}
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestUndoRedoDelete(t *testing.T) {
+	m, path := newProfilesTestModel(t) // Default roster: Alice
+
+	if err := m.deleteColleague(0); err != nil {
+		t.Fatalf("deleteColleague failed: %v", err)
+	}
+	if err := m.undo(); err != nil {
+		t.Fatalf("undo failed: %v", err)
+	}
+	if len(m.colleagues) != 1 || m.colleagues[0].Colleague.Name != "Alice" {
+		t.Fatalf("undo did not restore Alice: %+v", m.config.Colleagues)
+	}
+	if m.statusMsg != "undid: delete Alice" {
+		t.Errorf("statusMsg = %q, want %q", m.statusMsg, "undid: delete Alice")
+	}
+
+	// Undo goes through the normal save path
+	loaded, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig failed: %v", err)
+	}
+	if len(loaded.Colleagues) != 1 || len(loaded.Profiles) != 2 {
+		t.Errorf("saved config after undo = %+v", loaded)
+	}
+
+	if err := m.redo(); err != nil {
+		t.Fatalf("redo failed: %v", err)
+	}
+	if len(m.colleagues) != 0 {
+		t.Errorf("redo did not delete again: %+v", m.config.Colleagues)
+	}
+	if m.statusMsg != "redid: delete Alice" {
+		t.Errorf("statusMsg = %q, want %q", m.statusMsg, "redid: delete Alice")
+	}
+}
+
+func TestUndoSequence(t *testing.T) {
+	m, _ := newProfilesTestModel(t)
+
+	if err := m.toggleTimeFormat(); err != nil {
+		t.Fatalf("toggleTimeFormat failed: %v", err)
+	}
+	if err := m.cycleColorScheme(); err != nil {
+		t.Fatalf("cycleColorScheme failed: %v", err)
+	}
+	scheme := m.config.ColorScheme
+
+	steps := []struct {
+		status string
+		format string
+		scheme string
+	}{
+		{"undid: color scheme", "12h", "nord"},
+		{"undid: time format", "24h", "nord"},
+		{"nothing to undo", "24h", "nord"},
+	}
+	for _, s := range steps {
+		if err := m.undo(); err != nil {
+			t.Fatalf("undo failed: %v", err)
+		}
+		if m.statusMsg != s.status || m.config.TimeFormat != s.format || m.config.ColorScheme != s.scheme {
+			t.Errorf("after undo: %q %s %s, want %q %s %s",
+				m.statusMsg, m.config.TimeFormat, m.config.ColorScheme, s.status, s.format, s.scheme)
+		}
+	}
+
+	// A new edit drops the redo stack
+	if err := m.redo(); err != nil {
+		t.Fatalf("redo failed: %v", err)
+	}
+	if err := m.toggleTimelineMode(); err != nil {
+		t.Fatalf("toggleTimelineMode failed: %v", err)
+	}
+	if err := m.redo(); err != nil {
+		t.Fatalf("redo failed: %v", err)
+	}
+	if m.statusMsg != "nothing to redo" || m.config.ColorScheme == scheme {
+		t.Errorf("redo after a new edit: %q scheme %s", m.statusMsg, m.config.ColorScheme)
+	}
+}
+
+func TestFailedSaveLeavesNoUndo(t *testing.T) {
+	m, _ := newProfilesTestModel(t) // Default roster: Alice
+	if err := m.toggleTimeFormat(); err != nil {
+		t.Fatalf("toggleTimeFormat failed: %v", err)
+	}
+	if err := m.undo(); err != nil {
+		t.Fatalf("undo failed: %v", err)
+	}
+	format := m.config.TimeFormat
+
+	// The config path's parent is a file, so the save fails
+	blocker := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(blocker, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	m.configPath = filepath.Join(blocker, "config.yaml")
+	if err := m.deleteColleague(0); err == nil {
+		t.Fatal("deleteColleague saved under a file, want an error")
+	}
+	if len(m.history.undo) != 0 || len(m.history.redo) != 1 {
+		t.Errorf("after a failed save: %d undo, %d redo entries, want 0 and the earlier redo", len(m.history.undo), len(m.history.redo))
+	}
+	if len(m.colleagues) != 1 || len(m.config.Trash) != 0 || m.config.TimeFormat != format {
+		t.Errorf("failed delete not rolled back: colleagues %+v, trash %+v", m.config.Colleagues, m.config.Trash)
+	}
+}
+
+func TestUndoKeysAndStatusTimeout(t *testing.T) {
+	m, _ := newProfilesTestModel(t)
+	if err := m.deleteColleague(0); err != nil {
+		t.Fatalf("deleteColleague failed: %v", err)
+	}
+
+	next, _ := m.handleNormalMode(keyMsg("u"))
+	m = next.(Model)
+	if len(m.colleagues) != 1 {
+		t.Fatalf("u did not undo: %+v", m.config.Colleagues)
+	}
+
+	m.statusAt = time.Now().Add(-StatusTimeout - time.Second)
+	next, _ = m.Update(TickMsg(time.Now()))
+	m = next.(Model)
+	if m.statusMsg != "" {
+		t.Errorf("statusMsg = %q after timeout, want cleared", m.statusMsg)
+	}
+}
+
+func TestHistoryClearedOnReloadAndProfileSwitch(t *testing.T) {
+	m, path := newProfilesTestModel(t)
+
+	if err := m.toggleTimeFormat(); err != nil {
+		t.Fatalf("toggleTimeFormat failed: %v", err)
+	}
+	if err := m.switchProfile("family"); err != nil {
+		t.Fatalf("switchProfile failed: %v", err)
+	}
+	if len(m.history.undo) != 0 {
+		t.Errorf("history survived a profile switch: %+v", m.history.undo)
+	}
+
+	if err := m.deleteColleague(0); err != nil {
+		t.Fatalf("deleteColleague failed: %v", err)
+	}
+	writeConfigWithMtime(t, path, profilesYAML, time.Now().Add(time.Hour))
+	m.maybeReloadConfig()
+	if len(m.history.undo) != 0 {
+		t.Errorf("history survived an external edit: %+v", m.history.undo)
+	}
+}
@@ -40,6 +40,17 @@ func newHourRangeInput(placeholder string) textinput.Model {
 	return input
 }
 
+// newTagsInput creates an input for a tags edit, with the current tags
+// as the placeholder (blank Enter keeps them, like the hours prompts)
+func newTagsInput(current []string) textinput.Model {
+	input := newNameInput()
+	input.Placeholder = "tag, tag  or  +add -remove"
+	if len(current) > 0 {
+		input.Placeholder = strings.Join(current, ", ")
+	}
+	return input
+}
+
 // hourRangeAction describes the outcome of parsing an hour-range input
 type hourRangeAction int
 
@@ -49,6 +60,22 @@ const (
 	hourRangeSet                          // Explicit start-end
 )
 
+// hourRangeEdit is one parsed step of the hours flow
+type hourRangeEdit struct {
+	action     hourRangeAction
+	start, end int
+}
+
+// apply updates a colleague's start/end fields for the edit
+func (e hourRangeEdit) apply(start, end **int) {
+	switch e.action {
+	case hourRangeReset:
+		*start, *end = nil, nil
+	case hourRangeSet:
+		*start, *end = HourPtr(e.start), HourPtr(e.end)
+	}
+}
+
 // parseHourRange parses hour-range input: "9-17" (set, wraparound like
 // "22-6" allowed), "" (keep current), or "default" (reset to defaults).
 // Hours must be 0-23.
@@ -84,9 +111,50 @@ func parseHourRange(input string) (hourRangeAction, int, int, error) {
 	return hourRangeSet, start, end, nil
 }
 
+// parseClockTime parses a time of day: "14", "14:30", "2pm", "9:30am".
+// Returns the hour (0-23) and minute.
+func parseClockTime(input string) (int, int, error) {
+	s := strings.ToLower(strings.TrimSpace(input))
+	suffix := ""
+	for _, sfx := range []string{"am", "pm"} {
+		if strings.HasSuffix(s, sfx) {
+			suffix, s = sfx, strings.TrimSpace(strings.TrimSuffix(s, sfx))
+		}
+	}
+
+	hourStr, minStr, hasMin := strings.Cut(s, ":")
+	hour, err := strconv.Atoi(hourStr)
+	if err != nil {
+		return 0, 0, fmt.Errorf("expected a time like 14:00 or 2pm, got %q", input)
+	}
+	minute := 0
+	if hasMin {
+		if minute, err = strconv.Atoi(minStr); err != nil || len(minStr) != 2 || minute > 59 {
+			return 0, 0, fmt.Errorf("invalid minutes in %q", input)
+		}
+	}
+
+	switch suffix {
+	case "":
+		if hour < 0 || hour > 23 {
+			return 0, 0, fmt.Errorf("hour must be 0-23, got %d", hour)
+		}
+	default:
+		if hour < 1 || hour > 12 {
+			return 0, 0, fmt.Errorf("hour must be 1-12 with am/pm, got %d", hour)
+		}
+		hour %= 12
+		if suffix == "pm" {
+			hour += 12
+		}
+	}
+	return hour, minute, nil
+}
+
 // exitToNormal returns the model to normal mode and clears state
 func (m *Model) exitToNormal() {
 	m.inputMode = ModeNormal
+	m.editTargets = nil
 	m.nameInput.Blur()
 	m.errorMsg = ""
 }
@@ -112,8 +180,6 @@ func (m *Model) enterEditSearchMode() {
 // Navigation is arrow-keys only: letters like k/j must remain typeable
 // since search is type-to-filter (e.g. "tokyo", "japan").
 func (m *Model) handleSearchNavigation(msg tea.KeyMsg) bool {
-	const maxVisible = 10
-
 	switch msg.String() {
 	case "up":
 		if m.searchCursor > 0 {
@@ -127,8 +193,8 @@ func (m *Model) handleSearchNavigation(msg tea.KeyMsg) bool {
 	case "down":
 		if m.searchCursor < len(m.searchResults)-1 {
 			m.searchCursor++
-			if m.searchCursor >= m.searchScrollOffset+maxVisible {
-				m.searchScrollOffset = m.searchCursor - maxVisible + 1
+			if rows := m.searchVisibleRows(); m.searchCursor >= m.searchScrollOffset+rows {
+				m.searchScrollOffset = m.searchCursor - rows + 1
 			}
 		}
 		return true
@@ -110,17 +110,15 @@ func TestApplyHours(t *testing.T) {
 	config := DefaultConfig()
 	m := NewModel(config, tmp)
 
-	if err := m.applyWorkHours(0, HourPtr(0), HourPtr(8)); err != nil {
-		t.Fatalf("applyWorkHours failed: %v", err)
+	work := hourRangeEdit{hourRangeSet, 0, 8}
+	sleep := hourRangeEdit{hourRangeSet, 10, 18}
+	if err := m.applyHours([]int{0}, work, sleep); err != nil {
+		t.Fatalf("applyHours failed: %v", err)
 	}
 	if got := m.config.Colleagues[0].GetWorkStart(); got != 0 {
 		t.Errorf("Work start = %d, want 0 (midnight)", got)
 	}
 
-	if err := m.applySleepHours(0, HourPtr(10), HourPtr(18)); err != nil {
-		t.Fatalf("applySleepHours failed: %v", err)
-	}
-
 	// Round-trip through the saved config
 	loaded, err := LoadConfig(tmp)
 	if err != nil {
@@ -132,17 +130,20 @@ func TestApplyHours(t *testing.T) {
 			c.GetWorkStart(), c.GetWorkEnd(), c.GetSleepStart(), c.GetSleepEnd())
 	}
 
-	// Reset back to defaults
-	if err := m.applyWorkHours(0, nil, nil); err != nil {
-		t.Fatalf("applyWorkHours reset failed: %v", err)
+	// Reset work back to defaults, keeping sleep
+	if err := m.applyHours([]int{0}, hourRangeEdit{action: hourRangeReset}, hourRangeEdit{}); err != nil {
+		t.Fatalf("applyHours reset failed: %v", err)
 	}
 	if got := m.config.Colleagues[0].GetWorkStart(); got != DefaultWorkStart {
 		t.Errorf("After reset work start = %d, want default %d", got, DefaultWorkStart)
 	}
+	if got := m.config.Colleagues[0].GetSleepStart(); got != 10 {
+		t.Errorf("Keep changed sleep start to %d, want 10", got)
+	}
 
 	// Out-of-range index is a no-op, not a panic
-	if err := m.applyWorkHours(99, HourPtr(1), HourPtr(2)); err != nil {
-		t.Errorf("Out-of-range applyWorkHours returned error: %v", err)
+	if err := m.applyHours([]int{99}, work, sleep); err != nil {
+		t.Errorf("Out-of-range applyHours returned error: %v", err)
 	}
 }
 
@@ -397,3 +398,35 @@ func TestHandleSearchNavigationTyping(t *testing.T) {
 		t.Errorf("Expected search query 'new ' after space, got '%s'", m.searchQuery)
 	}
 }
+
+func TestParseClockTime(t *testing.T) {
+	tests := []struct {
+		input      string
+		wantHour   int
+		wantMinute int
+		wantErr    bool
+	}{
+		{"14", 14, 0, false},
+		{"14:30", 14, 30, false},
+		{"0:05", 0, 5, false},
+		{"2pm", 14, 0, false},
+		{"9:30 am", 9, 30, false},
+		{"12am", 0, 0, false},
+		{"12pm", 12, 0, false},
+		{"24", 0, 0, true},
+		{"13pm", 0, 0, true},
+		{"9:5", 0, 0, true},
+		{"noon", 0, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			hour, minute, err := parseClockTime(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseClockTime(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && (hour != tt.wantHour || minute != tt.wantMinute) {
+				t.Errorf("parseClockTime(%q) = %d:%02d, want %d:%02d", tt.input, hour, minute, tt.wantHour, tt.wantMinute)
+			}
+		})
+	}
+}
@@ -0,0 +1,327 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/mattn/go-runewidth"
+	"gopkg.in/yaml.v3"
+)
+
+// KeyList is the set of keys bound to one action in the config's keys
+// section. It accepts a single key ("?") or a list ([up, k]); an empty
+// list unbinds the action.
+type KeyList []string
+
+// UnmarshalYAML accepts a scalar or a sequence of key names
+func (k *KeyList) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		*k = KeyList{node.Value}
+		return nil
+	}
+	var keys []string
+	if err := node.Decode(&keys); err != nil {
+		return err
+	}
+	*k = keys
+	return nil
+}
+
+// keyScope is the set of modes in which a binding is active; bindings
+// only conflict when their scopes overlap
+type keyScope int
+
+const (
+	scopeNormal keyScope = 1 << iota
+	scopeTimeline
+
+	scopeBoth = scopeNormal | scopeTimeline
+)
+
+// KeyMap holds the configurable key bindings for normal and timeline
+// mode. Prompts (name entry, timezone search, hour editing) keep their
+// fixed Enter/Esc/arrow keys so typed text is never swallowed.
+type KeyMap struct {
+	Up       key.Binding
+	Down     key.Binding
+	Add      key.Binding
+	Edit     key.Binding
+	Hours    key.Binding
+	Delete   key.Binding
+	Format   key.Binding
+	Timeline key.Binding // Enter timeline mode; in timeline mode, leave it
+	Help     key.Binding
+	Quit     key.Binding // Quit; in timeline mode, back to normal mode
+	Back     key.Binding // Quit; in timeline mode, reset scrub then leave
+	Profiles key.Binding // Open the profile switcher
+	Anchor   key.Binding // Re-anchor offsets to a colleague's zone
+	Undo     key.Binding
+	Redo     key.Binding
+	Sort     key.Binding // Cycle sort order (config, offset, name, status)
+	Filter   key.Binding // Open the live filter prompt
+	Command  key.Binding // Open the ':' command line
+	Details  key.Binding // Toggle the detail pane for the selected colleague
+	Mark     key.Binding // Mark/unmark the selected colleague for bulk actions
+	Tags     key.Binding // Edit tags of the selected or marked colleagues
+	Trash    key.Binding // Open the trash screen
+	MoveUp   key.Binding // Move the selected colleague up in config order
+	MoveDown key.Binding
+	Split    key.Binding // Toggle the timeline bars beside the list
+
+	// Timeline mode; the scrub keys also work in the split view
+	Mode             key.Binding
+	Colors           key.Binding
+	ScrubBack        key.Binding
+	ScrubForward     key.Binding
+	ScrubBackFine    key.Binding // 15-minute steps
+	ScrubForwardFine key.Binding
+	ScrubBackDay     key.Binding // Whole-day steps
+	ScrubForwardDay  key.Binding
+	PrevDay          key.Binding // Jump to the previous/next midnight
+	NextDay          key.Binding
+	Goto             key.Binding // Go-to-time prompt
+	Window           key.Binding // Cycle the timeline window size
+	RowLabels        key.Binding // Cycle per-row hour labels (individual mode)
+	Week             key.Binding // Week heatmap
+}
+
+// keySpec describes one configurable action: its config name and the
+// modes it is active in
+type keySpec struct {
+	name    string
+	binding *key.Binding
+	scope   keyScope
+}
+
+// specs lists every configurable action
+func (km *KeyMap) specs() []keySpec {
+	return []keySpec{
+		{"up", &km.Up, scopeBoth},
+		{"down", &km.Down, scopeBoth},
+		{"add", &km.Add, scopeNormal},
+		{"edit", &km.Edit, scopeNormal},
+		{"hours", &km.Hours, scopeNormal},
+		{"delete", &km.Delete, scopeNormal},
+		{"details", &km.Details, scopeNormal},
+		{"mark", &km.Mark, scopeNormal},
+		{"tags", &km.Tags, scopeNormal},
+		{"trash", &km.Trash, scopeNormal},
+		{"move_up", &km.MoveUp, scopeNormal},
+		{"move_down", &km.MoveDown, scopeNormal},
+		{"split", &km.Split, scopeNormal},
+		{"format", &km.Format, scopeNormal},
+		{"timeline", &km.Timeline, scopeBoth},
+		{"mode", &km.Mode, scopeTimeline},
+		{"colors", &km.Colors, scopeTimeline},
+		{"scrub_back", &km.ScrubBack, scopeBoth},
+		{"scrub_forward", &km.ScrubForward, scopeBoth},
+		{"scrub_back_fine", &km.ScrubBackFine, scopeBoth},
+		{"scrub_forward_fine", &km.ScrubForwardFine, scopeBoth},
+		{"scrub_back_day", &km.ScrubBackDay, scopeBoth},
+		{"scrub_forward_day", &km.ScrubForwardDay, scopeBoth},
+		{"prev_day", &km.PrevDay, scopeBoth},
+		{"next_day", &km.NextDay, scopeBoth},
+		{"goto", &km.Goto, scopeTimeline},
+		{"window", &km.Window, scopeTimeline},
+		{"row_labels", &km.RowLabels, scopeTimeline},
+		{"week", &km.Week, scopeTimeline},
+		{"help", &km.Help, scopeBoth},
+		{"quit", &km.Quit, scopeBoth},
+		{"back", &km.Back, scopeBoth},
+		{"profiles", &km.Profiles, scopeBoth},
+		{"anchor", &km.Anchor, scopeBoth},
+		{"undo", &km.Undo, scopeBoth},
+		{"redo", &km.Redo, scopeBoth},
+		{"sort", &km.Sort, scopeBoth},
+		{"filter", &km.Filter, scopeBoth},
+		{"command", &km.Command, scopeBoth},
+	}
+}
+
+// DefaultKeyMap returns the built-in bindings
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		Up:           newBinding("up", "up", "k"),
+		Down:         newBinding("down", "down", "j"),
+		Add:          newBinding("add", "a"),
+		Edit:         newBinding("edit", "e"),
+		Hours:        newBinding("hours", "w"),
+		Delete:       newBinding("delete", "d"),
+		Format:       newBinding("format", "f"),
+		Timeline:     newBinding("timeline", "t"),
+		Help:         newBinding("help", "?", "h"),
+		Quit:         newBinding("quit", "q"),
+		Back:         newBinding("back", "esc"),
+		Profiles:     newBinding("profiles", "p"),
+		Anchor:       newBinding("anchor", "z"),
+		Undo:         newBinding("undo", "u"),
+		Redo:         newBinding("redo", "ctrl+r"),
+		Sort:         newBinding("sort", "s"),
+		Filter:       newBinding("filter", "/"),
+		Command:      newBinding("command", ":"),
+		Details:      newBinding("details", "enter"),
+		Mark:         newBinding("mark", " "),
+		Tags:         newBinding("tags", "T"),
+		Trash:        newBinding("trash", "D"),
+		MoveUp:       newBinding("move up", "K", "shift+up"),
+		MoveDown:     newBinding("move down", "J", "shift+down"),
+		Split:        newBinding("split view", "v"),
+		Mode:         newBinding("mode", "m"),
+		Colors:       newBinding("cycle colors", "c"),
+		ScrubBack:    newBinding("scrub back", "left"),
+		ScrubForward: newBinding("scrub forward", "right"),
+
+		ScrubBackFine:    newBinding("scrub back 15m", "shift+left"),
+		ScrubForwardFine: newBinding("scrub forward 15m", "shift+right"),
+		ScrubBackDay:     newBinding("scrub back a day", "alt+left"),
+		ScrubForwardDay:  newBinding("scrub forward a day", "alt+right"),
+		PrevDay:          newBinding("previous midnight", "["),
+		NextDay:          newBinding("next midnight", "]"),
+		Goto:             newBinding("go to time", "g"),
+		Window:           newBinding("window size", "w"),
+		RowLabels:        newBinding("row labels", "L"),
+		Week:             newBinding("week heatmap", "W"),
+	}
+}
+
+// newBinding creates a binding whose help label is derived from its keys
+func newBinding(desc string, keys ...string) key.Binding {
+	return key.NewBinding(key.WithKeys(keys...), key.WithHelp(keyLabel(keys), desc))
+}
+
+// keyLabels are display forms for named keys
+var keyLabels = map[string]string{
+	"up":    "↑",
+	"down":  "↓",
+	"left":  "←",
+	"right": "→",
+	"esc":   "Esc",
+	"enter": "Enter",
+	" ":     "space",
+}
+
+// keyLabel renders keys for the footer and help screen, e.g. "↑/k"
+func keyLabel(keys []string) string {
+	labels := make([]string, len(keys))
+	for i, k := range keys {
+		if l, ok := keyLabels[k]; ok {
+			labels[i] = l
+		} else {
+			labels[i] = k
+		}
+	}
+	return strings.Join(labels, "/")
+}
+
+// primaryKeyLabel renders only a binding's first key, for footer
+// entries that pair two actions (e.g. "↑/↓ scroll")
+func primaryKeyLabel(b key.Binding) string {
+	keys := b.Keys()
+	if len(keys) == 0 {
+		return ""
+	}
+	return keyLabel(keys[:1])
+}
+
+// NewKeyMap builds the key map from the defaults plus the config's
+// keys section, rejecting unknown actions and keys bound to two
+// actions that are active in the same mode
+func NewKeyMap(overrides map[string]KeyList) (KeyMap, error) {
+	km := DefaultKeyMap()
+	specs := km.specs()
+
+	known := make(map[string]keySpec, len(specs))
+	for _, s := range specs {
+		known[s.name] = s
+	}
+
+	// Apply overrides in name order so errors are stable
+	names := make([]string, 0, len(overrides))
+	for name := range overrides {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		s, ok := known[name]
+		if !ok {
+			return KeyMap{}, fmt.Errorf("keys: unknown action %q", name)
+		}
+		keys := overrides[name]
+		for _, k := range keys {
+			switch {
+			case strings.TrimSpace(k) == "" && k != " ":
+				return KeyMap{}, fmt.Errorf("keys: %s has an empty key", name)
+			case k == "ctrl+c":
+				return KeyMap{}, fmt.Errorf("keys: ctrl+c is reserved for force quit (bound to %s)", name)
+			}
+		}
+		desc := s.binding.Help().Desc
+		if len(keys) == 0 {
+			s.binding.Unbind()
+			continue
+		}
+		*s.binding = newBinding(desc, keys...)
+	}
+
+	if err := checkKeyConflicts(specs); err != nil {
+		return KeyMap{}, err
+	}
+	return km, nil
+}
+
+// checkKeyConflicts reports the first key bound to two actions whose
+// scopes overlap
+func checkKeyConflicts(specs []keySpec) error {
+	for i, a := range specs {
+		for _, b := range specs[i+1:] {
+			if a.scope&b.scope == 0 {
+				continue
+			}
+			for _, ka := range a.binding.Keys() {
+				for _, kb := range b.binding.Keys() {
+					if ka == kb {
+						return fmt.Errorf("keys: %q is bound to both %s and %s", ka, a.name, b.name)
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// footerItem formats a binding for a footer line: its key label and
+// desc (the binding's own description when desc is ""). Unbound
+// actions render as "" and are dropped by joinFooter.
+func footerItem(b key.Binding, desc string) string {
+	if !b.Enabled() {
+		return ""
+	}
+	if desc == "" {
+		desc = b.Help().Desc
+	}
+	return b.Help().Key + " " + desc
+}
+
+// joinFooter joins non-empty footer items with the footer separator
+func joinFooter(items ...string) string {
+	kept := items[:0]
+	for _, item := range items {
+		if item != "" {
+			kept = append(kept, item)
+		}
+	}
+	return strings.Join(kept, " • ")
+}
+
+// helpLine formats one help-screen row: a padded key label and a
+// description. Unbound actions are shown as such rather than hidden,
+// so the help screen documents what was turned off.
+func helpLine(b key.Binding, desc string) string {
+	label := b.Help().Key
+	if !b.Enabled() {
+		label = "(unbound)"
+	}
+	return "  " + runewidth.FillRight(label, 12) + " " + desc + "\n"
+}
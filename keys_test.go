@@ -0,0 +1,118 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/key"
+	"gopkg.in/yaml.v3"
+)
+
+func TestDefaultKeyMapHasNoConflicts(t *testing.T) {
+	km := DefaultKeyMap()
+	if err := checkKeyConflicts(km.specs()); err != nil {
+		t.Errorf("Default key map conflicts: %v", err)
+	}
+}
+
+func TestNewKeyMap(t *testing.T) {
+	t.Run("override replaces default keys", func(t *testing.T) {
+		km, err := NewKeyMap(map[string]KeyList{
+			"help":  {"?"},
+			"hours": {"H"},
+		})
+		if err != nil {
+			t.Fatalf("NewKeyMap failed: %v", err)
+		}
+		if key.Matches(keyMsg("h"), km.Help) {
+			t.Error("h should no longer open help once help is rebound to ?")
+		}
+		if !key.Matches(keyMsg("H"), km.Hours) {
+			t.Error("H should trigger hours after rebinding")
+		}
+		if km.Hours.Help().Key != "H" || km.Hours.Help().Desc != "hours" {
+			t.Errorf("Hours help = %+v, want key H and default description", km.Hours.Help())
+		}
+	})
+
+	t.Run("empty list unbinds", func(t *testing.T) {
+		km, err := NewKeyMap(map[string]KeyList{"delete": {}})
+		if err != nil {
+			t.Fatalf("NewKeyMap failed: %v", err)
+		}
+		if km.Delete.Enabled() || key.Matches(keyMsg("d"), km.Delete) {
+			t.Error("Expected delete to be unbound")
+		}
+	})
+
+	tests := []struct {
+		name      string
+		overrides map[string]KeyList
+		wantErr   string
+	}{
+		{"unknown action", map[string]KeyList{"teleport": {"x"}}, "unknown action"},
+		{"same-mode conflict", map[string]KeyList{"add": {"d"}}, "bound to both"},
+		{"conflict with shared action", map[string]KeyList{"mode": {"k"}}, "bound to both"},
+		{"reserved ctrl+c", map[string]KeyList{"quit": {"ctrl+c"}}, "reserved"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewKeyMap(tt.overrides)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("NewKeyMap(%v) error = %v, want %q", tt.overrides, err, tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("keys in different modes may overlap", func(t *testing.T) {
+		// "mode" is timeline-only and "add" is normal-only
+		if _, err := NewKeyMap(map[string]KeyList{"mode": {"a"}}); err != nil {
+			t.Errorf("Expected no conflict across modes, got %v", err)
+		}
+	})
+}
+
+func TestKeyListYAML(t *testing.T) {
+	var keys map[string]KeyList
+	input := "help: \"?\"\nup: [up, k]\n"
+	if err := yaml.Unmarshal([]byte(input), &keys); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if len(keys["help"]) != 1 || keys["help"][0] != "?" {
+		t.Errorf("help = %v, want [?]", keys["help"])
+	}
+	if len(keys["up"]) != 2 {
+		t.Errorf("up = %v, want [up k]", keys["up"])
+	}
+}
+
+func TestReboundKeysDriveHandlersAndFooter(t *testing.T) {
+	config := DefaultConfig()
+	config.Keys = map[string]KeyList{"add": {"+"}, "help": {"?"}}
+	m := NewModel(config, t.TempDir()+"/config.yaml")
+
+	next, _ := m.handleNormalMode(keyMsg("h"))
+	if next.(Model).inputMode != ModeNormal {
+		t.Error("h must not open help after it was unbound from help")
+	}
+
+	next, _ = m.handleNormalMode(keyMsg("+"))
+	if next.(Model).inputMode != ModeAddName {
+		t.Error("Rebound add key did not start the add flow")
+	}
+
+	footer := m.renderFooter()
+	if !strings.Contains(footer, "+ add") {
+		t.Errorf("Footer should show the rebound add key, got %q", footer)
+	}
+	if strings.Contains(footer, "a add") {
+		t.Errorf("Footer still shows the default add key: %q", footer)
+	}
+}
+
+func TestLoadConfigRejectsKeyConflicts(t *testing.T) {
+	data := []byte("keys:\n  add: d\ncolleagues: []\n")
+	if _, err := parseConfig(data); err == nil {
+		t.Error("Expected parseConfig to reject conflicting key bindings")
+	}
+}
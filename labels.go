@@ -0,0 +1,211 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Per-row timeline labels (individual mode), the timeline_row_labels
+// setting
+const (
+	RowLabelsOff   = ""      // Hour labels only once under the bars
+	RowLabelsOwn   = "own"   // Each row labelled on its colleague's clock
+	RowLabelsLocal = "local" // Each row labelled on the reference clock
+)
+
+// RowLabelModes are the row label settings the row labels key cycles
+// through
+var RowLabelModes = []string{RowLabelsOff, RowLabelsOwn, RowLabelsLocal}
+
+// labelSteps are the hours between labels to choose from, densest first
+var labelSteps = []int{1, 3, 6, 12}
+
+// ValidateRowLabels checks a timeline_row_labels value; "off" is
+// accepted as RowLabelsOff
+func ValidateRowLabels(mode string) error {
+	switch normalizeRowLabels(mode) {
+	case RowLabelsOff, RowLabelsOwn, RowLabelsLocal:
+		return nil
+	}
+	return fmt.Errorf("timeline_row_labels must be off, %q or %q, got %q", RowLabelsOwn, RowLabelsLocal, mode)
+}
+
+// normalizeRowLabels maps the "off" the footer shows to RowLabelsOff
+func normalizeRowLabels(mode string) string {
+	if mode == "off" {
+		return RowLabelsOff
+	}
+	return mode
+}
+
+// hourLabel is a label centered on a bar position (0 to barWidth)
+type hourLabel struct {
+	pos  int
+	text string
+}
+
+// hourLabelText formats an hour of the day (24 for a window-ending
+// midnight) in the 12h ("3a", "12p") or 24h format
+func hourLabelText(hour int, format string) string {
+	if format != "12h" {
+		return strconv.Itoa(hour)
+	}
+	hour %= 24
+	suffix := "a"
+	if hour >= 12 {
+		suffix = "p"
+	}
+	if hour%12 == 0 {
+		return "12" + suffix
+	}
+	return strconv.Itoa(hour%12) + suffix
+}
+
+// hourLabelWidth returns the widest wall-clock label in a format
+func hourLabelWidth(format string) int {
+	if format == "12h" {
+		return len("12a")
+	}
+	return len("24")
+}
+
+// hourLabelStep returns the hours between labels: the densest step that
+// leaves room for labels of labelWidth and a space between them
+func hourLabelStep(hours, barWidth, labelWidth int) int {
+	for _, step := range labelSteps {
+		if step*barWidth >= (labelWidth+1)*hours {
+			return step
+		}
+	}
+	return labelSteps[len(labelSteps)-1]
+}
+
+// wallClockLabels labels window w on its own clock, at every step hours
+// of the day (24 at a window-ending midnight)
+func wallClockLabels(w timelineWindow, barWidth int, format string) []hourLabel {
+	step := hourLabelStep(w.hours, barWidth, hourLabelWidth(format))
+	var labels []hourLabel
+	for h := 0; h <= w.hours; h++ {
+		wall := (w.start.Hour() + h) % 24
+		if wall%step != 0 {
+			continue
+		}
+		if h == w.hours && wall == 0 && format != "12h" {
+			wall = 24
+		}
+		labels = append(labels, hourLabel{pos: h * barWidth / w.hours, text: hourLabelText(wall, format)})
+	}
+	return labels
+}
+
+// relativeLabels labels window w by hours from its center ("-6h",
+// "now", "+6h")
+func relativeLabels(w timelineWindow, barWidth int) []hourLabel {
+	// Wider than the labels: the end ones are pushed inwards by the
+	// brackets and would otherwise run into their neighbours
+	step := hourLabelStep(w.hours, barWidth, len(fmt.Sprintf("%+dh", w.hours/2))+2)
+	var labels []hourLabel
+	for h := 0; h <= w.hours; h++ {
+		d := h - w.hours/2
+		if d%step != 0 {
+			continue
+		}
+		text := "now"
+		if d != 0 {
+			text = fmt.Sprintf("%+dh", d)
+		}
+		labels = append(labels, hourLabel{pos: h * barWidth / w.hours, text: text})
+	}
+	return labels
+}
+
+// zoneLabels labels window w (drawn on another zone's clock) with the
+// hours of loc's clock, wherever they fall: half an hour into a cell
+// for India, or twice for the hour a DST change repeats
+func zoneLabels(w timelineWindow, barWidth int, loc *time.Location, format string) []hourLabel {
+	step := hourLabelStep(w.hours, barWidth, hourLabelWidth(format))
+	var labels []hourLabel
+	for t := w.start.Add(-time.Hour); ; t = t.Add(15 * time.Minute) {
+		hours := wallClock(t.In(w.start.Location())).Sub(wallClock(w.start)).Hours()
+		if hours > float64(w.hours) {
+			break
+		}
+		local := t.In(loc)
+		if hours < 0 || local.Minute() != 0 || local.Hour()%step != 0 {
+			continue
+		}
+		pos := int(hours/float64(w.hours)*float64(barWidth) + 0.5)
+		labels = append(labels, hourLabel{pos: pos, text: hourLabelText(local.Hour(), format)})
+	}
+	return labels
+}
+
+// placeLabels lays labels out under a bar of barWidth, in brackets
+// matching the bar's. Each label is centered on its position as far as
+// the brackets allow; one that would run into the previous label is
+// dropped.
+func placeLabels(labels []hourLabel, barWidth int) string {
+	line := []rune("[" + strings.Repeat(" ", barWidth) + "]")
+	for _, l := range labels {
+		// +1 accounts for the opening bracket
+		start := max(l.pos-len(l.text)/2+1, 1)
+		start = min(start, barWidth+1-len(l.text))
+		if start < 1 || strings.TrimSpace(string(line[max(start-1, 1):start+len(l.text)])) != "" {
+			continue
+		}
+		copy(line[start:], []rune(l.text))
+	}
+	return string(line)
+}
+
+// rowLabelsShown reports whether each timeline row gets a label line
+func (m Model) rowLabelsShown() bool {
+	return m.config.TimelineMode == "individual" && m.config.TimelineRowLabels != RowLabelsOff
+}
+
+// renderRowLabels renders the label line under a colleague's individual
+// bar: their own clock, or the reference clock at the same instants
+func (m Model) renderRowLabels(ct ColleagueTime) string {
+	nameWidth, timeWidth, barWidth := m.timelineLayout()
+	w := m.windowAt(ct.CurrentTime)
+	var labels []hourLabel
+	if m.config.TimelineRowLabels == RowLabelsLocal {
+		labels = zoneLabels(w, barWidth, m.referenceTimezone(), m.config.TimeFormat)
+	} else {
+		labels = wallClockLabels(w, barWidth, m.config.TimeFormat)
+	}
+	padding := strings.Repeat(" ", nameWidth+timeWidth+2)
+	return offHoursStyle.Render(padding + placeLabels(labels, barWidth))
+}
+
+// cycleRowLabels steps to the next row label setting and saves
+func (m *Model) cycleRowLabels() error {
+	next := RowLabelModes[0]
+	for i, mode := range RowLabelModes {
+		if mode == m.config.TimelineRowLabels {
+			next = RowLabelModes[(i+1)%len(RowLabelModes)]
+		}
+	}
+	return m.setRowLabels(next)
+}
+
+// setRowLabels sets the row label setting ("off" for none) and saves
+func (m *Model) setRowLabels(mode string) error {
+	mode = normalizeRowLabels(mode)
+	if err := ValidateRowLabels(mode); err != nil {
+		return fmt.Errorf("unknown row labels %q (use off, own or local)", mode)
+	}
+	m.recordHistory("row labels")
+	m.config.TimelineRowLabels = mode
+	return m.saveConfig()
+}
+
+// rowLabelsLabel describes the row label setting for the footer
+func (m Model) rowLabelsLabel() string {
+	if m.config.TimelineRowLabels == RowLabelsOff {
+		return "off"
+	}
+	return m.config.TimelineRowLabels
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// labelFields returns the label texts of a rendered label line
+func labelFields(line string) string {
+	return strings.Join(strings.Fields(strings.Trim(stripANSI(line), " \n[]")), " ")
+}
+
+func TestHourLabelText(t *testing.T) {
+	tests := []struct {
+		hour   int
+		format string
+		want   string
+	}{
+		{0, "24h", "0"},
+		{15, "24h", "15"},
+		{24, "24h", "24"},
+		{0, "12h", "12a"},
+		{3, "12h", "3a"},
+		{12, "12h", "12p"},
+		{21, "12h", "9p"},
+		{24, "12h", "12a"},
+	}
+	for _, tt := range tests {
+		if got := hourLabelText(tt.hour, tt.format); got != tt.want {
+			t.Errorf("hourLabelText(%d, %q) = %q, want %q", tt.hour, tt.format, got, tt.want)
+		}
+	}
+}
+
+func TestHourLabelStep(t *testing.T) {
+	tests := []struct {
+		hours, barWidth, labelWidth int
+		want                        int
+	}{
+		{24, 48, 2, 3},  // 2 chars per hour
+		{24, 24, 2, 3},  // 1 char per hour: "12 15" just fits
+		{24, 24, 3, 6},  // 12h labels need more room
+		{12, 48, 2, 1},  // 4 chars per hour: every hour
+		{12, 48, 3, 1},  // "12p" too
+		{72, 24, 2, 12}, // A third of a char per hour
+	}
+	for _, tt := range tests {
+		if got := hourLabelStep(tt.hours, tt.barWidth, tt.labelWidth); got != tt.want {
+			t.Errorf("hourLabelStep(%d, %d, %d) = %d, want %d", tt.hours, tt.barWidth, tt.labelWidth, got, tt.want)
+		}
+	}
+}
+
+func TestHourLabels12h(t *testing.T) {
+	m := Model{config: Config{TimeFormat: "12h"}}
+	day := timelineWindow{start: time.Date(2025, 1, 24, 0, 0, 0, 0, time.UTC), hours: 24}
+	if got := labelFields(m.renderHourLabels(day, 48, 0, false)); got != "12a 3a 6a 9a 12p 3p 6p 9p 12a" {
+		t.Errorf("12h labels = %q, want 12a 3a 6a 9a 12p 3p 6p 9p 12a", got)
+	}
+
+	// A 12h window is wide enough for every hour
+	m.config.TimeFormat = "24h"
+	half := timelineWindow{start: time.Date(2025, 1, 24, 9, 0, 0, 0, time.UTC), hours: 12}
+	if got := labelFields(m.renderHourLabels(half, 48, 0, false)); got != "9 10 11 12 13 14 15 16 17 18 19 20 21" {
+		t.Errorf("12h window labels = %q, want every hour 9-21", got)
+	}
+}
+
+// TestZoneLabels tests labelling a colleague's bar with another zone's
+// hours: India's hours fall half an hour into UTC's
+func TestZoneLabels(t *testing.T) {
+	india := time.FixedZone("IST", 5*3600+1800)
+	w := timelineWindow{start: time.Date(2025, 1, 24, 0, 0, 0, 0, india), hours: 24}
+	labels := zoneLabels(w, 48, time.UTC, "24h")
+	if len(labels) == 0 {
+		t.Fatal("no labels")
+	}
+	// 00:00 IST is 18:30 UTC, so 21:00 UTC is 2.5h in, at cell 5
+	if labels[0].text != "21" || labels[0].pos != 5 {
+		t.Errorf("first label = %+v, want 21 at 5", labels[0])
+	}
+	for _, l := range labels {
+		if l.pos%2 != 1 {
+			t.Errorf("label %+v on an IST hour boundary, want between them", l)
+		}
+	}
+}
+
+func TestRowLabels(t *testing.T) {
+	m, path := newReloadTestModel(t) // Alice (New York), Bob (London), Charlie (Tokyo)
+	m.localTimezone = time.UTC
+	m.inputMode = ModeTimeline
+
+	m.height = 40
+	rows := m.visibleRows()
+	if err := m.cycleRowLabels(); err != nil {
+		t.Fatal(err)
+	}
+	if m.config.TimelineRowLabels != RowLabelsOwn {
+		t.Fatalf("after cycling from off: %q, want own", m.config.TimelineRowLabels)
+	}
+	if got := strings.Count(stripANSI(m.View()), "[0  "); got != 4 {
+		t.Errorf("label lines = %d, want one per row and the bottom one", got)
+	}
+	if got := m.visibleRows(); got >= rows {
+		t.Errorf("visibleRows with row labels = %d, want fewer than %d", got, rows)
+	}
+
+	if err := m.setRowLabels(RowLabelsLocal); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.setRowLabels("both"); err == nil {
+		t.Error("setRowLabels(both) succeeded, want an error")
+	}
+
+	loaded, err := LoadConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.TimelineRowLabels != RowLabelsLocal {
+		t.Errorf("saved row labels = %q, want local", loaded.TimelineRowLabels)
+	}
+	// The footer's "off" is also accepted in the file
+	config, err := parseConfig([]byte("timeline_row_labels: off\n"))
+	if err != nil || config.TimelineRowLabels != RowLabelsOff {
+		t.Errorf("timeline_row_labels: off = %q, %v; want off", config.TimelineRowLabels, err)
+	}
+}
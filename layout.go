@@ -0,0 +1,247 @@
+package main
+
+import (
+	"strings"
+	"time"
+
+	"github.com/mattn/go-runewidth"
+)
+
+// Layout is derived from the last tea.WindowSizeMsg. Until one arrives
+// (and in tests) width/height are 0 and the fixed defaults apply.
+const (
+	MinVisible        = 3  // Fewest list rows shown, however short the terminal
+	MinNameFieldWidth = 12 // Names are truncated no further than this
+	MaxNameFieldWidth = 40 // Timeline name column grows up to this for long names
+	SearchChromeLines = 8  // Header, prompt, indicators and footer around search results
+)
+
+// visibleRows returns how many colleague rows fit in the list or
+// timeline view, after the lines the rest of the screen needs
+func (m Model) visibleRows() int {
+	if m.height <= 0 {
+		return MaxVisible
+	}
+
+	// Rendered blocks include their margins. Scroll indicators and the
+	// error/status line are always reserved so the layout doesn't jump.
+	var chrome int
+	if m.viewMode() == ModeTimeline {
+		// Header (+ blank), indicators, overlap row, hour labels,
+		// legend, status, footer
+		chrome = wrappedHeight(m.renderTimelineHeader(), m.width) + 1 + 2 + 1 + 1
+		if m.config.TimelineMode == "shared" {
+			chrome++
+		}
+		if m.renderTimelineDates() != "" {
+			chrome++
+		}
+		chrome += wrappedHeight(m.renderTimelineLegend(), m.width)
+		chrome += wrappedHeight(m.renderTimelineFooter(), m.width)
+	} else {
+		// Header, indicators, error/status, the split view's overlap
+		// row and labels, detail pane, footer
+		chrome = wrappedHeight(m.renderHeader(), m.width) + 2 + 1
+		chrome += strings.Count(m.renderSplitExtras(), "\n")
+		if detail := m.renderDetail(); detail != "" {
+			chrome += 1 + wrappedHeight(detail, m.width)
+		}
+		chrome += wrappedHeight(m.renderFooter(), m.width)
+	}
+	chrome += m.meHeight()
+	switch m.inputMode {
+	case ModeFilter, ModeCommand, ModeGoto:
+		chrome += 2 // Prompt line above the footer
+	case ModeRange:
+		// The popup replaces the footer
+		footer := m.renderTimelineFooter()
+		if m.viewMode() == ModeNormal {
+			footer = m.renderFooter()
+		}
+		chrome += wrappedHeight(m.renderRangePopup(), m.width) - wrappedHeight(footer, m.width)
+	}
+	if m.viewMode() == ModeTimeline && m.rowLabelsShown() {
+		// Each row takes its label line too
+		return max((m.height-chrome)/2, MinVisible)
+	}
+	return max(m.height-chrome, MinVisible)
+}
+
+// searchVisibleRows returns how many timezone search results fit
+func (m Model) searchVisibleRows() int {
+	if m.height <= 0 {
+		return MaxSearchVisible
+	}
+	return max(m.height-SearchChromeLines, MinVisible)
+}
+
+// wrappedHeight returns how many terminal lines s occupies when lines
+// longer than width wrap. width <= 0 means no wrapping.
+func wrappedHeight(s string, width int) int {
+	lines := strings.Split(strings.TrimRight(s, "\n"), "\n")
+	if width <= 0 {
+		return len(lines)
+	}
+	n := 0
+	for _, line := range lines {
+		// Rendered blocks are padded to their widest line; the padding
+		// doesn't wrap visibly, so measure without it
+		w := runewidth.StringWidth(strings.TrimRight(stripANSI(line), " "))
+		n += max((w+width-1)/width, 1)
+	}
+	return n
+}
+
+// stripANSI removes SGR escape sequences so rendered text can be measured
+func stripANSI(s string) string {
+	var b strings.Builder
+	inEscape := false
+	for _, r := range s {
+		switch {
+		case r == '\x1b':
+			inEscape = true
+		case inEscape:
+			if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') {
+				inEscape = false
+			}
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// scrollToCursor adjusts the scroll offset so the cursor row is visible
+func (m *Model) scrollToCursor() {
+	rows := m.visibleRows()
+	if m.cursor >= 0 && m.cursor < m.scrollOffset {
+		m.scrollOffset = m.cursor
+	} else if m.cursor >= m.scrollOffset+rows {
+		m.scrollOffset = m.cursor - rows + 1
+	}
+	m.clampScroll()
+}
+
+// clampScroll keeps the scroll offset within the list, e.g. after the
+// list shrank or the terminal grew
+func (m *Model) clampScroll() {
+	maxScroll := max(len(m.colleagues)-m.visibleRows(), 0)
+	m.scrollOffset = max(min(m.scrollOffset, maxScroll), 0)
+}
+
+// timelineLayout returns the name, time and bar widths for timeline
+// rows. The bar keeps at least MinBarWidth: on narrow terminals the
+// time and then the name column shrink first; on wide ones long names
+// get room up to MaxNameFieldWidth once the bar is at least IdealBarWidth.
+func (m Model) timelineLayout() (nameWidth, timeWidth, barWidth int) {
+	nameWidth, timeWidth = NameFieldWidth, TimeFieldWidth
+	barWidth = m.calculateTimelineBarWidth()
+	if m.width <= 0 {
+		return nameWidth, timeWidth, barWidth
+	}
+
+	// Name, time, separators and bar brackets
+	fixed := 7
+	if m.width-nameWidth-timeWidth-fixed < MinBarWidth {
+		// Just the time itself, no spare padding
+		timeWidth = runewidth.StringWidth(FormatTime(time.Date(2000, 1, 1, 23, 59, 59, 0, time.UTC), m.config.TimeFormat))
+		nameWidth = max(min(nameWidth, m.width-timeWidth-fixed-MinBarWidth), MinNameFieldWidth)
+		return nameWidth, timeWidth, MinBarWidth
+	}
+
+	if barWidth >= IdealBarWidth {
+		spare := m.width - nameWidth - timeWidth - fixed - barWidth
+		longest := 0
+		for _, ct := range m.withMe() {
+			longest = max(longest, runewidth.StringWidth(ct.Colleague.Name))
+		}
+		nameWidth = max(nameWidth, min(longest, nameWidth+spare, MaxNameFieldWidth))
+	}
+	return nameWidth, timeWidth, barWidth
+}
+
+// listLayout describes which list columns fit and how wide they are
+type listLayout struct {
+	columns []string       // Shown columns, in order
+	widths  map[string]int // Display width per column
+}
+
+// listLayout fits the configured columns to the terminal width, or to
+// the list's side of the split view
+func (m Model) listLayout() listLayout {
+	if l, _, ok := m.splitLayout(); ok {
+		return l
+	}
+	return m.fitListLayout(m.width)
+}
+
+// fitListLayout fits the configured columns to width (0 = unlimited).
+// Columns are aligned across rows. When the row is too wide, names
+// longer than NameFieldWidth are cut first; then columns are dropped
+// from the right (name, time and status are kept); only then are names
+// cut further.
+func (m Model) fitListLayout(width int) listLayout {
+	l := listLayout{
+		columns: m.activeColumns(),
+		widths:  make(map[string]int),
+	}
+	for _, ct := range m.withMe() {
+		l.widths["name"] = max(l.widths["name"], runewidth.StringWidth(ct.Colleague.Name))
+		if ct.InvalidTimezone {
+			continue
+		}
+		for _, name := range l.columns {
+			w := runewidth.StringWidth(listColumns[name].value(m, ct))
+			l.widths[name] = max(l.widths[name], w)
+		}
+	}
+
+	// Columns empty on every row (e.g. no DST change this week) take no space
+	shown := l.columns[:0:0]
+	for _, name := range l.columns {
+		if l.widths[name] > 0 || name == "name" {
+			shown = append(shown, name)
+		}
+	}
+	l.columns = shown
+	if width <= 0 {
+		return l
+	}
+
+	over := func() int { return l.rowWidth() - width }
+	if o := over(); o > 0 && l.widths["name"] > NameFieldWidth {
+		l.widths["name"] = max(l.widths["name"]-o, NameFieldWidth)
+	}
+	for i := len(l.columns) - 1; i >= 0 && over() > 0; i-- {
+		switch l.columns[i] {
+		case "name", "time", "status":
+			continue
+		}
+		l.columns = append(l.columns[:i:i], l.columns[i+1:]...)
+	}
+	if o := over(); o > 0 {
+		l.widths["name"] = max(l.widths["name"]-o, MinNameFieldWidth)
+	}
+	return l
+}
+
+// rowWidth is the display width of a row: row padding and cursor,
+// then the columns separated by two spaces (one after the status glyph)
+func (l listLayout) rowWidth() int {
+	w := 4
+	for i, name := range l.columns {
+		if i > 0 {
+			w += columnGap(l.columns[i-1])
+		}
+		w += l.widths[name]
+	}
+	return w
+}
+
+// columnGap is the space after a column
+func columnGap(name string) int {
+	if name == "status" {
+		return 1
+	}
+	return 2
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"slices"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestVisibleRowsFollowsTerminalHeight(t *testing.T) {
+	m, _ := newReloadTestModel(t)
+	if got := m.visibleRows(); got != MaxVisible {
+		t.Errorf("visibleRows() before any resize = %d, want %d", got, MaxVisible)
+	}
+
+	m.width = 120
+	m.height = 40
+	tall := m.visibleRows()
+	m.height = 20
+	short := m.visibleRows()
+	if tall-short != 20 {
+		t.Errorf("visibleRows() = %d at height 40 and %d at 20, want a difference of 20", tall, short)
+	}
+
+	m.height = 5
+	if got := m.visibleRows(); got != MinVisible {
+		t.Errorf("visibleRows() on a tiny terminal = %d, want %d", got, MinVisible)
+	}
+
+	if got := m.searchVisibleRows(); got != MinVisible {
+		t.Errorf("searchVisibleRows() on a tiny terminal = %d, want %d", got, MinVisible)
+	}
+	m.height = 40
+	if got := m.searchVisibleRows(); got != 40-SearchChromeLines {
+		t.Errorf("searchVisibleRows() = %d, want %d", got, 40-SearchChromeLines)
+	}
+}
+
+func TestResizeKeepsCursorVisible(t *testing.T) {
+	m, _ := newReloadTestModel(t)
+	for i := range 20 {
+		m.config.Colleagues = append(m.config.Colleagues, newColleague(string(rune('A'+i)), "UTC"))
+	}
+	m.updateColleagueTimes()
+	m.cursor = 20
+	m.selectionActive = true
+
+	next, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 15})
+	m = next.(Model)
+	rows := m.visibleRows()
+	if m.cursor < m.scrollOffset || m.cursor >= m.scrollOffset+rows {
+		t.Errorf("cursor %d outside visible rows %d-%d after resize", m.cursor, m.scrollOffset, m.scrollOffset+rows-1)
+	}
+}
+
+func TestListLayoutNarrowTerminal(t *testing.T) {
+	m, _ := newReloadTestModel(t)
+	m.config.Colleagues = append(m.config.Colleagues, newColleague("A Rather Long Colleague Name (Sydney)", "Australia/Sydney"))
+	m.updateColleagueTimes()
+
+	tests := []struct {
+		width      int
+		showOffset bool
+		showDate   bool
+	}{
+		{0, true, true}, // Size unknown: everything, untruncated
+		{120, true, true},
+		{55, true, false},
+		{40, false, false},
+	}
+	for _, tt := range tests {
+		m.width = tt.width
+		l := m.listLayout()
+		showOffset := slices.Contains(l.columns, "offset")
+		showDate := slices.Contains(l.columns, "date")
+		if showOffset != tt.showOffset || showDate != tt.showDate {
+			t.Errorf("width %d: columns %v, want offset %v date %v",
+				tt.width, l.columns, tt.showOffset, tt.showDate)
+		}
+		if tt.width > 0 && l.widths["name"] < MinNameFieldWidth {
+			t.Errorf("width %d: name width %d below minimum", tt.width, l.widths["name"])
+		}
+		if tt.width > 0 && l.rowWidth() > tt.width && l.widths["name"] > MinNameFieldWidth {
+			t.Errorf("width %d: row is %d cells wide", tt.width, l.rowWidth())
+		}
+	}
+}
+
+func TestTimelineLayoutFitsWidth(t *testing.T) {
+	m, _ := newReloadTestModel(t)
+	for _, width := range []int{50, 60, 80, 100, 200} {
+		m.width = width
+		name, timeWidth, bar := m.timelineLayout()
+		if bar < MinBarWidth {
+			t.Errorf("width %d: bar %d below MinBarWidth", width, bar)
+		}
+		// Name, space, time, space, [bar]
+		if total := name + timeWidth + bar + 4; total > width {
+			t.Errorf("width %d: row is %d cells wide", width, total)
+		}
+	}
+}
@@ -14,20 +14,42 @@ import (
 
 func main() {
 	// Parse CLI flags
-	configPath := flag.String("config", "", "Path to config file (default: ~/.config/tui-clock/config.yaml)")
+	configPath := flag.String("config", "", "Path to config file (default: $TUI_CLOCK_CONFIG, ./.tui-clock.yaml or a parent's, $XDG_CONFIG_HOME/tui-clock/config.yaml, ~/.config/tui-clock/config.yaml)")
+	profile := flag.String("profile", DefaultProfile, "Profile (named roster from the config) to start with")
+	tz := flag.String("tz", "", "Reference timezone for offsets, e.g. Europe/Berlin (default: local_timezone from the config, else the system zone)")
+	flag.Usage = func() {
+		out := flag.CommandLine.Output()
+		fmt.Fprintf(out, "Usage: %s [flags]\n       %s [flags] config path\n\nFlags:\n", os.Args[0], os.Args[0])
+		flag.PrintDefaults()
+	}
 	flag.Parse()
 
 	// Determine config path
-	var finalConfigPath string
+	var finalConfigPath, configSource string
 	if *configPath != "" {
-		finalConfigPath = *configPath
+		finalConfigPath, configSource = *configPath, ConfigSourceFlag
 	} else {
-		defaultPath, err := GetDefaultConfigPath()
+		path, source, err := ResolveConfigPath()
 		if err != nil {
 			fmt.Printf("Error getting default config path: %v\n", err)
 			os.Exit(1)
 		}
-		finalConfigPath = defaultPath
+		finalConfigPath, configSource = path, source
+	}
+
+	// Subcommands
+	switch args := flag.Args(); {
+	case len(args) == 0:
+		// Run the TUI
+	case len(args) == 2 && args[0] == "config" && args[1] == "path":
+		// Path on stdout so it composes ($EDITOR "$(tui-clock config path)");
+		// the rule that chose it on stderr
+		fmt.Println(finalConfigPath)
+		fmt.Fprintf(os.Stderr, "(from %s)\n", configSource)
+		return
+	default:
+		flag.Usage()
+		os.Exit(2)
 	}
 
 	// Load config
@@ -39,9 +61,23 @@ func main() {
 
 	// Create model
 	model := NewModel(config, finalConfigPath)
+	model.configSource = configSource
+	if err := model.switchProfile(*profile); err != nil {
+		fmt.Printf("Error selecting profile: %v\n", err)
+		os.Exit(1)
+	}
+	if err := model.setTimezoneOverride(*tz); err != nil {
+		fmt.Printf("Error: -tz: %v\n", err)
+		os.Exit(1)
+	}
 
 	// Create program
-	p := tea.NewProgram(model, tea.WithAltScreen())
+	options := []tea.ProgramOption{tea.WithAltScreen()}
+	if config.MouseEnabled() {
+		// Cell motion: drags report movement while a button is held
+		options = append(options, tea.WithMouseCellMotion())
+	}
+	p := tea.NewProgram(model, options...)
 
 	// Run program
 	if _, err := p.Run(); err != nil {
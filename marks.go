@@ -0,0 +1,195 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Marks select several colleagues for bulk actions (hours, delete,
+// tags) and narrow the timeline's overlap row. They are kept as config
+// indices, so they survive sorting and filtering. Moves carry them
+// along; anything else that changes the roster's indices (delete,
+// undo, reload, profile switch) clears them.
+
+// toggleMark marks or unmarks the colleague on a display row
+func (m *Model) toggleMark(row int) {
+	if row < 0 || row >= len(m.colleagues) {
+		return
+	}
+	index := m.colleagues[row].ConfigIndex
+	if m.marked[index] {
+		delete(m.marked, index)
+		return
+	}
+	if m.marked == nil {
+		m.marked = make(map[int]bool)
+	}
+	m.marked[index] = true
+}
+
+// clearMarks drops all marks
+func (m *Model) clearMarks() {
+	m.marked = nil
+}
+
+// markedIndices returns the marked config indices in ascending order
+func (m Model) markedIndices() []int {
+	indices := make([]int, 0, len(m.marked))
+	for index := range m.marked {
+		if index < len(m.config.Colleagues) {
+			indices = append(indices, index)
+		}
+	}
+	sort.Ints(indices)
+	return indices
+}
+
+// actionTargets returns the config indices a bulk-capable action
+// applies to: the marked colleagues, else the cursor row. Returns nil
+// when there is neither.
+func (m Model) actionTargets() []int {
+	if indices := m.markedIndices(); len(indices) > 0 {
+		return indices
+	}
+	if m.cursor >= 0 && m.cursor < len(m.colleagues) && m.selectionActive {
+		return []int{m.colleagues[m.cursor].ConfigIndex}
+	}
+	return nil
+}
+
+// editTargetIndices returns the config indices the open hours or tags
+// prompt applies to
+func (m Model) editTargetIndices() []int {
+	if len(m.editTargets) > 0 {
+		return m.editTargets
+	}
+	return []int{m.editIndex}
+}
+
+// targetsName describes a set of config indices for prompts and
+// history: the colleague's name, or "N colleagues"
+func (m Model) targetsName(indices []int) string {
+	if len(indices) == 1 {
+		return m.colleagueName(indices[0])
+	}
+	return fmt.Sprintf("%d colleagues", len(indices))
+}
+
+// deleteColleagues moves several colleagues to the trash as one undo
+// step and one save
+func (m *Model) deleteColleagues(indices []int) error {
+	if len(indices) == 0 {
+		return nil
+	}
+	if len(indices) == 1 {
+		return m.deleteColleague(indices[0])
+	}
+
+	m.recordHistory("delete " + m.targetsName(indices))
+	m.trashColleagues(indices)
+	m.updateColleagueTimes()
+	m.cursor = -1
+	m.selectionActive = false
+	m.clampScroll()
+	return m.saveConfig()
+}
+
+// tagEdit is a parsed tags prompt: either a replacement list, or tags
+// to add (+tag) and remove (-tag)
+type tagEdit struct {
+	set         []string
+	add, remove []string
+	replace     bool
+}
+
+// parseTagEdit parses tags input. "a, b" replaces the tags, "+a -b"
+// adds a and removes b, and "-" clears them. Separators are commas or
+// spaces.
+func parseTagEdit(input string) (tagEdit, error) {
+	fields := strings.FieldsFunc(input, func(r rune) bool { return r == ',' || r == ' ' })
+	if len(fields) == 0 {
+		return tagEdit{}, fmt.Errorf("enter tags (a, b), +tag / -tag, or - to clear")
+	}
+	if len(fields) == 1 && fields[0] == "-" {
+		return tagEdit{replace: true}, nil
+	}
+
+	var e tagEdit
+	relative := 0
+	for _, f := range fields {
+		switch {
+		case strings.HasPrefix(f, "+") && len(f) > 1:
+			e.add = append(e.add, f[1:])
+			relative++
+		case strings.HasPrefix(f, "-") && len(f) > 1:
+			e.remove = append(e.remove, f[1:])
+			relative++
+		default:
+			e.set = append(e.set, f)
+		}
+	}
+	if relative > 0 && len(e.set) > 0 {
+		return tagEdit{}, fmt.Errorf("mix of plain and +/- tags; use one form")
+	}
+	e.replace = relative == 0
+	return e, nil
+}
+
+// apply returns tags with the edit applied, without duplicates
+func (e tagEdit) apply(tags []string) []string {
+	base := tags
+	if e.replace {
+		base = e.set
+	}
+	removed := make(map[string]bool, len(e.remove))
+	for _, t := range e.remove {
+		removed[t] = true
+	}
+
+	var result []string
+	seen := make(map[string]bool)
+	for _, t := range append(append([]string{}, base...), e.add...) {
+		if removed[t] || seen[t] {
+			continue
+		}
+		seen[t] = true
+		result = append(result, t)
+	}
+	return result
+}
+
+// applyTags edits the tags of several colleagues as one undo step and
+// one save
+func (m *Model) applyTags(indices []int, edit tagEdit) error {
+	if len(indices) == 0 {
+		return nil
+	}
+	m.recordHistory("tags for " + m.targetsName(indices))
+	for _, index := range indices {
+		if index >= 0 && index < len(m.config.Colleagues) {
+			m.config.Colleagues[index].Tags = edit.apply(m.config.Colleagues[index].Tags)
+		}
+	}
+	m.updateColleagueTimes()
+	return m.saveConfig()
+}
+
+// markedLabel returns the header tag for marked colleagues, or ""
+func (m Model) markedLabel() string {
+	if len(m.marked) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("  ✓ %d marked", len(m.marked))
+}
+
+// markedColleagueTimes returns the displayed rows that are marked
+func (m Model) markedColleagueTimes() []ColleagueTime {
+	var cts []ColleagueTime
+	for _, ct := range m.colleagues {
+		if m.marked[ct.ConfigIndex] {
+			cts = append(cts, ct)
+		}
+	}
+	return cts
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// markRows marks the given display rows through the mark key
+func markRows(t *testing.T, m Model, rows ...int) Model {
+	t.Helper()
+	for _, row := range rows {
+		m.cursor = row
+		m.activateSelection()
+		next, _ := m.handleNormalMode(keyMsg(" "))
+		m = next.(Model)
+	}
+	return m
+}
+
+func TestBulkHoursEdit(t *testing.T) {
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+	m, path := newReloadTestModel(t) // Alice (New York), Bob (London), Charlie (Tokyo)
+	m = markRows(t, m, 0, 2)
+	if got := m.markedIndices(); !reflect.DeepEqual(got, []int{0, 2}) {
+		t.Fatalf("markedIndices() = %v, want [0 2]", got)
+	}
+
+	next, _ := m.handleNormalMode(keyMsg("w"))
+	m = next.(Model)
+	if m.inputMode != ModeEditWorkHours || m.editTargetName() != "2 colleagues" {
+		t.Fatalf("Expected bulk hours prompt, got mode %v target %q", m.inputMode, m.editTargetName())
+	}
+	m.nameInput.SetValue("6-14")
+	next, _ = m.handleEditWorkHoursMode(enter)
+	m = next.(Model)
+	next, _ = m.handleEditSleepHoursMode(enter)
+	m = next.(Model)
+
+	loaded, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig failed: %v", err)
+	}
+	for i, want := range []int{6, DefaultWorkStart, 6} {
+		if got := loaded.Colleagues[i].GetWorkStart(); got != want {
+			t.Errorf("%s work start = %d, want %d", loaded.Colleagues[i].Name, got, want)
+		}
+	}
+
+	// One edit, one undo step
+	if err := m.undo(); err != nil {
+		t.Fatalf("undo failed: %v", err)
+	}
+	if m.statusMsg != "undid: hours for 2 colleagues" {
+		t.Errorf("statusMsg = %q, want %q", m.statusMsg, "undid: hours for 2 colleagues")
+	}
+	if m.config.Colleagues[0].WorkStart != nil || m.config.Colleagues[2].WorkStart != nil {
+		t.Error("undo should restore both colleagues' hours")
+	}
+}
+
+func TestBulkDelete(t *testing.T) {
+	m, path := newReloadTestModel(t)
+	m = markRows(t, m, 0, 1)
+
+	next, _ := m.handleNormalMode(keyMsg("d"))
+	m = next.(Model)
+	if got := stripANSI(m.renderConfirmDelete()); !strings.Contains(got, "Delete 2 colleagues?") {
+		t.Errorf("confirm prompt = %q, want it to name 2 colleagues", got)
+	}
+	next, _ = m.handleConfirmDeleteMode(keyMsg("y"))
+	m = next.(Model)
+	loaded, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig failed: %v", err)
+	}
+	if len(loaded.Colleagues) != 1 || loaded.Colleagues[0].Name != "Charlie (Tokyo)" {
+		t.Errorf("Expected only Charlie left, got %+v", loaded.Colleagues)
+	}
+	if len(m.marked) != 0 {
+		t.Errorf("Marks should be cleared after delete, got %v", m.marked)
+	}
+}
+
+func TestMarksFollowMove(t *testing.T) {
+	m, _ := newReloadTestModel(t)
+	m = markRows(t, m, 0)
+
+	if err := m.moveColleague(0, 1); err != nil {
+		t.Fatalf("moveColleague failed: %v", err)
+	}
+	if got := m.markedIndices(); !reflect.DeepEqual(got, []int{1}) {
+		t.Errorf("markedIndices() = %v, want [1] (Alice moved down)", got)
+	}
+}
+
+func TestParseTagEdit(t *testing.T) {
+	current := []string{"oncall", "design"}
+	tests := []struct {
+		input   string
+		want    []string
+		wantErr bool
+	}{
+		{"eng, emea", []string{"eng", "emea"}, false},
+		{"+eng -oncall", []string{"design", "eng"}, false},
+		{"+design", []string{"oncall", "design"}, false}, // No duplicates
+		{"-", nil, false},
+		{"eng +emea", nil, true},
+		{"  ", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			edit, err := parseTagEdit(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseTagEdit(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got := edit.apply(current); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("apply(%v) = %v, want %v", current, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarkedOverlapRow(t *testing.T) {
+	m, _ := newReloadTestModel(t)
+	m.config.TimelineMode = "shared"
+	if row := stripANSI(m.renderOverlapRow()); !strings.Contains(row, "/3 now") {
+		t.Fatalf("overlap row %q should count all three", row)
+	}
+
+	m = markRows(t, m, 0, 1)
+	row := stripANSI(m.renderOverlapRow())
+	if !strings.Contains(row, "Marked overlap") || !strings.Contains(row, "/2 now") {
+		t.Errorf("overlap row %q should count only the two marked", row)
+	}
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DefaultMeName names your own row when the me: section doesn't
+const DefaultMeName = "Me"
+
+// Me is the config's me: section: your own hours, shown as a row pinned
+// above the colleagues on the home zone's clock. Hours follow the
+// colleague rules: unset hours use the defaults.
+type Me struct {
+	Name       string   `yaml:"name,omitempty"` // Row name; "" = DefaultMeName
+	WorkStart  *int     `yaml:"work_start,omitempty"`
+	WorkEnd    *int     `yaml:"work_end,omitempty"`
+	SleepStart *int     `yaml:"sleep_start,omitempty"`
+	SleepEnd   *int     `yaml:"sleep_end,omitempty"`
+	Workdays   []string `yaml:"workdays,omitempty"` // e.g. [mon, tue, wed, thu]; empty = Monday to Friday
+	Overlap    bool     `yaml:"overlap,omitempty"`  // Count me in the team-overlap row and the week heatmap
+}
+
+// colleague returns your row as a colleague whose clock is loc
+func (me Me) colleague(loc *time.Location) Colleague {
+	name := me.Name
+	if name == "" {
+		name = DefaultMeName
+	}
+	return Colleague{
+		Name:       name,
+		Timezone:   loc.String(),
+		WorkStart:  me.WorkStart,
+		WorkEnd:    me.WorkEnd,
+		SleepStart: me.SleepStart,
+		SleepEnd:   me.SleepEnd,
+		workdays:   me.Workdays,
+	}
+}
+
+// ValidateWorkdays checks a workdays list: full or three-letter day
+// names
+func ValidateWorkdays(days []string) error {
+	for _, day := range days {
+		if parseWeekday(strings.ToLower(day)) < 0 {
+			return fmt.Errorf("unknown workday %q (use mon, tue, wed, thu, fri, sat, sun)", day)
+		}
+	}
+	return nil
+}
+
+// validateConfigWorkdays checks the me: section's workdays
+func validateConfigWorkdays(config Config) error {
+	if config.Me == nil {
+		return nil
+	}
+	if err := ValidateWorkdays(config.Me.Workdays); err != nil {
+		return fmt.Errorf("me: %w", err)
+	}
+	return nil
+}
+
+// updateMe recomputes your row: on the home zone's clock, with its
+// offset from the reference zone, so it stays put when anchoring
+func (m *Model) updateMe() {
+	m.me = nil
+	if m.config.Me == nil || m.localTimezone == nil {
+		return
+	}
+	me := colleagueTimeIn(m.config.Me.colleague(m.localTimezone), m.localTimezone, m.referenceTimezone(), time.Now())
+	me.ConfigIndex = -1
+	m.me = &me
+}
+
+// withMe returns your row, if any, followed by the listed colleagues:
+// the rows column widths are fitted to
+func (m Model) withMe() []ColleagueTime {
+	if m.me == nil {
+		return m.colleagues
+	}
+	return append([]ColleagueTime{*m.me}, m.colleagues...)
+}
+
+// meHeight returns how many lines your pinned row takes: like any
+// timeline row, it can have a label line under its bar
+func (m Model) meHeight() int {
+	switch {
+	case m.me == nil:
+		return 0
+	case m.viewMode() == ModeTimeline && m.rowLabelsShown():
+		return 2
+	}
+	return 1
+}
+
+// renderMeRow renders your pinned list row (with its bar in the split
+// view), or "" without one
+func (m Model) renderMeRow(layout listLayout) string {
+	if m.me == nil {
+		return ""
+	}
+	me := m.scrubbed(*m.me)
+	row := m.renderColleagueRow(-1, me, layout)
+	if _, barWidth, ok := m.splitLayout(); ok {
+		row = m.renderSplitRow(row, me, layout, barWidth)
+	}
+	return row + "\n"
+}
+
+// renderMeTimelineRow renders your pinned timeline row, with its label
+// line when rows are labelled, or "" without one
+func (m Model) renderMeTimelineRow() string {
+	if m.me == nil {
+		return ""
+	}
+	me := m.scrubbed(*m.me)
+	if m.config.TimelineMode != "individual" {
+		return m.renderSharedTimelineRow(-1, me) + "\n"
+	}
+	row := m.renderTimelineRow(-1, me)
+	if m.rowLabelsShown() {
+		row += "\n" + m.renderRowLabels(me)
+	}
+	return row + "\n"
+}
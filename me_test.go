@@ -0,0 +1,101 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestIsWorkday(t *testing.T) {
+	friday := time.Date(2025, 1, 24, 12, 0, 0, 0, time.UTC)
+	saturday := friday.AddDate(0, 0, 1)
+	tests := []struct {
+		workdays []string
+		day      time.Time
+		want     bool
+	}{
+		{nil, friday, true},
+		{nil, saturday, false},
+		{[]string{"mon", "tue", "wed", "thu"}, friday, false},
+		{[]string{"Sunday", "Sat"}, saturday, true},
+	}
+	for _, tt := range tests {
+		c := Me{Workdays: tt.workdays}.colleague(time.UTC)
+		if got := c.IsWorkday(tt.day); got != tt.want {
+			t.Errorf("IsWorkday(%v) with %v = %v, want %v", tt.day.Weekday(), tt.workdays, got, tt.want)
+		}
+	}
+}
+
+func TestMeConfig(t *testing.T) {
+	config, err := parseConfig([]byte(`me:
+  work_start: 8
+  work_end: 16
+  workdays: [mon, tue, wed, thu]
+  overlap: true
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	me := config.Me.colleague(time.UTC)
+	if me.Name != DefaultMeName || me.GetWorkStart() != 8 || me.GetSleepStart() != DefaultSleepStart || !config.Me.Overlap {
+		t.Errorf("me = %+v, want Me working 8-16 with the default sleep hours", me)
+	}
+
+	if _, err := parseConfig([]byte("me:\n  workdays: [mon, funday]\n")); err == nil {
+		t.Error("parseConfig with an unknown workday succeeded, want an error")
+	}
+}
+
+func TestMeRow(t *testing.T) {
+	m, _ := newReloadTestModel(t) // Alice (New York), Bob (London), Charlie (Tokyo)
+	m.localTimezone = time.UTC
+	m.config.Me = &Me{Name: "Sam"}
+	m.updateColleagueTimes()
+	m.width, m.height = 120, 30
+
+	for _, mode := range []InputMode{ModeNormal, ModeTimeline} {
+		m.inputMode = mode
+		m.scrollOffset = 1
+		view := m.View()
+
+		// Pinned: above the scroll indicator, and not a selectable row
+		me, more := lineOf(view, "Sam"), lineOf(view, "more above")
+		if me < 0 || me > more {
+			t.Errorf("mode %v: Sam on line %d, want above the indicator on %d", mode, me, more)
+		}
+		if got := m.rowAt(me); got != -1 {
+			t.Errorf("mode %v: rowAt(Sam) = %d, want -1", mode, got)
+		}
+		if got := m.rowAt(lineOf(view, "Bob (London)")); got != 1 {
+			t.Errorf("mode %v: rowAt(Bob) = %d, want 1", mode, got)
+		}
+	}
+
+	// A filter narrows the colleagues, not you
+	m.inputMode = ModeNormal
+	m.scrollOffset = 0
+	m.setFilter("tokyo")
+	if view := stripANSI(m.View()); !strings.Contains(view, "Sam") || strings.Contains(view, "Alice") {
+		t.Errorf("filtered view:\n%s", view)
+	}
+}
+
+func TestMeOverlap(t *testing.T) {
+	m, _ := newReloadTestModel(t)
+	m.localTimezone = time.UTC
+	m.config.Me = &Me{}
+	m.updateColleagueTimes()
+
+	if label, counted := m.overlapColleagues(); len(counted) != 3 || label != "Team overlap" {
+		t.Errorf("overlap without opting in: %q over %d, want Team overlap over 3", label, len(counted))
+	}
+	m.config.Me.Overlap = true
+	label, counted := m.overlapColleagues()
+	if len(counted) != 4 || label != "Team overlap with me" {
+		t.Errorf("overlap with me: %q over %d, want Team overlap with me over 4", label, len(counted))
+	}
+	if len(m.colleagues) != 3 {
+		t.Errorf("counting me added a row: %d colleagues", len(m.colleagues))
+	}
+}
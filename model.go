@@ -1,7 +1,9 @@
 package main
 
 import (
+	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -12,14 +14,23 @@ type TickMsg time.Time
 
 // NewModel creates a new model with the given config
 func NewModel(config Config, configPath string) Model {
-	// Auto-detect local timezone
-	localTz := time.Now().Location()
+	// Reference timezone: configured, or auto-detected (local_timezone
+	// was validated by parseConfig; a hand-built Config falls back)
+	localTz, err := resolveLocalTimezone("", config.LocalTimezone)
+	if err != nil {
+		localTz = time.Now().Location()
+	}
 
 	// Create text input (will be replaced with fresh instance when entering add/edit mode)
 	nameInput := newNameInput()
 
+	// Start on the default profile; main switches for --profile
+	view, _ := config.forProfile(DefaultProfile)
+
 	m := Model{
-		config:          config,
+		config:          view,
+		fullConfig:      config,
+		profile:         DefaultProfile,
 		configPath:      configPath,
 		localTimezone:   localTz,
 		inputMode:       ModeNormal,
@@ -42,6 +53,13 @@ func NewModel(config Config, configPath string) Model {
 		m.configSize = info.Size()
 	}
 
+	// Register custom color schemes and color the UI
+	m.applyColorScheme()
+	m.applyKeyMap()
+
+	// Expired trash is dropped here and written out with the next save
+	m.purgeTrash(time.Now())
+
 	// Compute initial times
 	m.updateColleagueTimes()
 
@@ -72,10 +90,124 @@ func newColleague(name, timezone string) Colleague {
 	}
 }
 
-// displayNow returns the local time the timeline should render: the
-// real current time plus any scrub offset
+// resolveLocalTimezone picks the home zone: the -tz override, then
+// the config's local_timezone, then the process's zone (which is often
+// UTC inside containers and SSH sessions)
+func resolveLocalTimezone(override, configured string) (*time.Location, error) {
+	switch {
+	case override != "":
+		return time.LoadLocation(override)
+	case configured != "":
+		return time.LoadLocation(configured)
+	default:
+		return time.Now().Location(), nil
+	}
+}
+
+// setTimezoneOverride applies the -tz flag for this session; it takes
+// precedence over local_timezone and is never saved
+func (m *Model) setTimezoneOverride(name string) error {
+	if name == "" {
+		return nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return fmt.Errorf("invalid timezone %q: %w", name, err)
+	}
+	m.tzOverride = name
+	m.localTimezone = loc
+	m.updateColleagueTimes()
+	return nil
+}
+
+// referenceTimezone returns the zone offsets, the header clock and the
+// shared timeline are measured from: the anchored colleague's zone if
+// one is set, otherwise the home zone
+func (m Model) referenceTimezone() *time.Location {
+	if m.anchorTz != nil {
+		return m.anchorTz
+	}
+	return m.localTimezone
+}
+
+// referenceLabel names the reference zone for headers
+func (m Model) referenceLabel() string {
+	if m.anchorTz != nil {
+		return "⚓ " + m.anchorName
+	}
+	return "Local Time"
+}
+
+// cycleAnchor re-anchors offsets and the shared timeline to a
+// colleague's zone ("view the day as Tokyo sees it"). With an active
+// selection it anchors to the selected colleague, or releases the
+// anchor if it is already theirs; otherwise it steps through the
+// displayed colleagues and back to the home zone.
+func (m *Model) cycleAnchor() {
+	if m.cursor >= 0 && m.cursor < len(m.colleagues) && m.selectionActive {
+		ct := m.colleagues[m.cursor]
+		if m.isAnchoredTo(ct) {
+			m.clearAnchor()
+		} else {
+			m.setAnchor(ct)
+		}
+		return
+	}
+
+	var valid []ColleagueTime
+	for _, ct := range m.colleagues {
+		if !ct.InvalidTimezone {
+			valid = append(valid, ct)
+		}
+	}
+	if len(valid) == 0 {
+		return
+	}
+
+	// Step to the entry after the current anchor (the first one when
+	// unanchored); past the last, release
+	next := 0
+	for i, ct := range valid {
+		if m.isAnchoredTo(ct) {
+			next = i + 1
+		}
+	}
+	if next >= len(valid) {
+		m.clearAnchor()
+		return
+	}
+	m.setAnchor(valid[next])
+}
+
+// isAnchoredTo reports whether the anchor came from ct
+func (m Model) isAnchoredTo(ct ColleagueTime) bool {
+	return m.anchorTz != nil && m.anchorName == ct.Colleague.Name &&
+		m.anchorTz.String() == ct.Colleague.Timezone
+}
+
+// setAnchor anchors the reference zone to a colleague's zone
+func (m *Model) setAnchor(ct ColleagueTime) {
+	loc, err := time.LoadLocation(ct.Colleague.Timezone)
+	if err != nil {
+		m.errorMsg = fmt.Sprintf("can't anchor to %s: %v", ct.Colleague.Name, err)
+		return
+	}
+	m.anchorTz = loc
+	m.anchorName = ct.Colleague.Name
+	m.updateColleagueTimes()
+}
+
+// clearAnchor returns the reference zone to the home zone
+func (m *Model) clearAnchor() {
+	m.anchorTz = nil
+	m.anchorName = ""
+	m.updateColleagueTimes()
+}
+
+// displayNow returns the reference-zone time the timeline should
+// render: the real current time plus any scrub offset
 func (m Model) displayNow() time.Time {
-	return time.Now().In(m.localTimezone).Add(m.timeOffset)
+	return time.Now().In(m.referenceTimezone()).Add(m.timeOffset)
 }
 
 // scrubbed returns a copy of ct shifted by the scrub offset, with the
@@ -85,16 +217,67 @@ func (m Model) scrubbed(ct ColleagueTime) ColleagueTime {
 	if m.timeOffset == 0 || ct.InvalidTimezone {
 		return ct
 	}
-	ct.CurrentTime = ct.CurrentTime.Add(m.timeOffset)
-	ct.IsWeekend = ct.CurrentTime.Weekday() == time.Saturday || ct.CurrentTime.Weekday() == time.Sunday
+	// Re-derive everything offset-dependent for the shifted instant:
+	// scrubbing across a DST change moves the colleague's (or the
+	// reference zone's) UTC offset and zone abbreviation
+	loc := ct.CurrentTime.Location()
+	ct.CurrentTime = ct.CurrentTime.Add(m.timeOffset).In(loc)
+	ct.Offset = formatOffsetString(calculateOffsetHours(ct.CurrentTime, m.referenceTimezone()))
+	ct.DSTChangeAt, ct.DSTDeltaHours, ct.HasDSTChange = nextOffsetChange(loc, ct.CurrentTime, DSTLookahead)
+	ct.IsWeekend = !ct.Colleague.IsWorkday(ct.CurrentTime)
 	ct.IsWorkingTime = !ct.IsWeekend &&
 		isInTimeRange(ct.CurrentTime.Hour(), ct.Colleague.GetWorkStart(), ct.Colleague.GetWorkEnd())
+	ct.NextWorkChange = nextWorkChange(ct.Colleague, ct.CurrentTime)
 	return ct
 }
 
-// updateColleagueTimes recomputes all colleague times
+// applyColorScheme registers the config's custom color schemes and
+// recolors the UI styles for the active scheme. Call after anything
+// that changes ColorScheme or ColorSchemes. Custom schemes were
+// validated by parseConfig, so a build error here can only come from a
+// hand-built Config; its custom schemes are then simply unavailable.
+func (m *Model) applyColorScheme() {
+	customs, err := buildCustomColorSchemes(m.config.ColorSchemes)
+	if err != nil {
+		customs = nil
+	}
+	setCustomColorSchemes(customs)
+	applyUIColorScheme(getCurrentColorScheme(m.config.ColorScheme))
+}
+
+// applyKeyMap rebuilds the key bindings from the config. Overrides
+// were validated by parseConfig; a hand-built Config with invalid ones
+// falls back to the defaults.
+func (m *Model) applyKeyMap() {
+	keys, err := NewKeyMap(m.config.Keys)
+	if err != nil {
+		keys = DefaultKeyMap()
+	}
+	m.keys = keys
+}
+
+// updateColleagueTimes recomputes all colleague times in display
+// order. Sorted orders can change as the clock runs (status, DST), so
+// the cursor follows the selected colleague rather than the row.
 func (m *Model) updateColleagueTimes() {
-	m.colleagues = ComputeColleagueTimes(m.config.Colleagues, m.localTimezone)
+	selected := -1
+	if m.cursor >= 0 && m.cursor < len(m.colleagues) {
+		selected = m.colleagues[m.cursor].ConfigIndex
+	}
+
+	m.colleagues = ComputeColleagueTimes(m.config.Colleagues, m.referenceTimezone())
+	sortColleagueTimes(m.colleagues, m.config.SortBy)
+	m.colleagues = filterColleagueTimes(m.colleagues, m.filterQuery)
+	m.updateMe()
+
+	if selected >= 0 && m.config.SortBy != SortByConfig {
+		for i, ct := range m.colleagues {
+			if ct.ConfigIndex == selected {
+				m.cursor = i
+				break
+			}
+		}
+	}
 }
 
 // saveConfig saves the current config to file and records the
@@ -103,10 +286,19 @@ func (m *Model) updateColleagueTimes() {
 // external edit made while the app holds unsaved intent (e.g. an open
 // prompt) is overwritten by this save, same as before hot-reload
 // existed.
+//
+// Only the active profile's roster (plus shared settings) is written
+// back; other profiles are saved as they were last loaded. A failed
+// save rolls back the edit recorded for undo just before it.
 func (m *Model) saveConfig() error {
-	if err := SaveConfig(m.configPath, m.config); err != nil {
+	m.purgeTrash(time.Now())
+	full := m.fullConfig.withProfile(m.profile, m.config)
+	if err := SaveConfig(m.configPath, full); err != nil {
+		m.rollbackHistory()
 		return err
 	}
+	m.commitHistory()
+	m.fullConfig = full
 	if info, err := os.Stat(m.configPath); err == nil {
 		m.configMtime = info.ModTime()
 		m.configSize = info.Size()
@@ -123,7 +315,7 @@ func (m *Model) saveConfig() error {
 // than recreated.
 func (m *Model) maybeReloadConfig() {
 	switch m.inputMode {
-	case ModeNormal, ModeTimeline, ModeHelp:
+	case ModeNormal, ModeTimeline, ModeHelp, ModeProfiles, ModeFilter, ModeCommand, ModeGoto, ModeWeek, ModeRange:
 		// Safe to reload
 	default:
 		return
@@ -150,9 +342,28 @@ func (m *Model) maybeReloadConfig() {
 		return
 	}
 
+	// Stay on the active profile unless the edit removed it
+	if !config.HasProfile(m.profile) {
+		m.errorMsg = fmt.Sprintf("profile %q was removed from the config; switched to %s", m.profile, DefaultProfile)
+		m.profile = DefaultProfile
+	}
+	view, _ := config.forProfile(m.profile)
+
+	// local_timezone may have changed (the -tz flag still wins)
+	if loc, err := resolveLocalTimezone(m.tzOverride, config.LocalTimezone); err == nil {
+		m.localTimezone = loc
+	}
+
 	m.configMtime = info.ModTime()
 	m.configSize = info.Size()
-	m.config = config
+	m.fullConfig = config
+	m.config = view
+	m.profileCursor = min(m.profileCursor, len(config.ProfileNames())-1)
+	// Snapshots predate the external edit; undoing would overwrite it
+	m.clearHistory()
+	m.clearMarks()
+	m.applyColorScheme()
+	m.applyKeyMap()
 	m.updateColleagueTimes()
 
 	// The external edit may have reordered or replaced entries: drop
@@ -160,41 +371,69 @@ func (m *Model) maybeReloadConfig() {
 	// different colleague (matches in-app delete behavior)
 	m.cursor = -1
 	m.selectionActive = false
-	maxScroll := max(len(m.colleagues)-MaxVisible, 0)
-	if m.scrollOffset > maxScroll {
-		m.scrollOffset = maxScroll
-	}
+	m.clampScroll()
 }
 
-// applyWorkHours sets a colleague's work hours (nil = use defaults) and saves
-func (m *Model) applyWorkHours(index int, start, end *int) error {
-	if index < 0 || index >= len(m.config.Colleagues) {
-		return nil
+// switchProfile makes another profile active: its colleagues, color
+// scheme and timeline mode replace the current ones. Nothing is saved;
+// every in-app edit has already been written through saveConfig.
+func (m *Model) switchProfile(name string) error {
+	full := m.fullConfig.withProfile(m.profile, m.config)
+	view, err := full.forProfile(name)
+	if err != nil {
+		return err
 	}
-	m.config.Colleagues[index].WorkStart = start
-	m.config.Colleagues[index].WorkEnd = end
+
+	m.fullConfig = full
+	m.config = view
+	m.profile = name
+	m.clearHistory() // Snapshots belong to the previous profile's roster
+	m.clearMarks()
+	m.applyColorScheme()
 	m.updateColleagueTimes()
-	return m.saveConfig()
+
+	// Indices into the previous roster are meaningless now
+	m.cursor = -1
+	m.selectionActive = false
+	m.scrollOffset = 0
+	return nil
 }
 
-// applySleepHours sets a colleague's sleep hours (nil = use defaults) and saves
-func (m *Model) applySleepHours(index int, start, end *int) error {
-	if index < 0 || index >= len(m.config.Colleagues) {
+// applyHours applies the work and sleep steps of the hours flow to
+// one or more colleagues as a single undo step and a single save.
+// Keep/keep is a no-op; out-of-range indices are skipped.
+func (m *Model) applyHours(indices []int, work, sleep hourRangeEdit) error {
+	if work.action == hourRangeKeep && sleep.action == hourRangeKeep {
 		return nil
 	}
-	m.config.Colleagues[index].SleepStart = start
-	m.config.Colleagues[index].SleepEnd = end
+	var valid []int
+	for _, index := range indices {
+		if index >= 0 && index < len(m.config.Colleagues) {
+			valid = append(valid, index)
+		}
+	}
+	if len(valid) == 0 {
+		return nil
+	}
+
+	m.recordHistory("hours for " + m.targetsName(valid))
+	for _, index := range valid {
+		c := &m.config.Colleagues[index]
+		work.apply(&c.WorkStart, &c.WorkEnd)
+		sleep.apply(&c.SleepStart, &c.SleepEnd)
+	}
 	m.updateColleagueTimes()
 	return m.saveConfig()
 }
 
-// deleteColleague removes a colleague and saves config
+// deleteColleague moves a colleague to the trash and saves config
 func (m *Model) deleteColleague(index int) error {
 	if index < 0 || index >= len(m.config.Colleagues) {
 		return nil
 	}
 
-	m.config.Colleagues = append(m.config.Colleagues[:index], m.config.Colleagues[index+1:]...)
+	m.recordHistory("delete " + m.colleagueName(index))
+	m.trashColleagues([]int{index})
 	m.updateColleagueTimes()
 
 	// Adjust cursor if necessary (cursor indexes the display list)
@@ -208,14 +447,98 @@ func (m *Model) deleteColleague(index int) error {
 // toggleTimeFormat switches between 12h and 24h format
 func (m *Model) toggleTimeFormat() error {
 	if m.config.TimeFormat == "12h" {
-		m.config.TimeFormat = "24h"
-	} else {
-		m.config.TimeFormat = "12h"
+		return m.setTimeFormat("24h")
+	}
+	return m.setTimeFormat("12h")
+}
+
+// setTimeFormat switches to "12h" or "24h" and saves
+func (m *Model) setTimeFormat(format string) error {
+	if format != "12h" && format != "24h" {
+		return fmt.Errorf("unknown time format %q (available: 12h, 24h)", format)
 	}
+	m.recordHistory("time format")
+	m.config.TimeFormat = format
 	m.updateColleagueTimes()
 	return m.saveConfig()
 }
 
+// cycleSortBy switches to the next sort order and saves
+func (m *Model) cycleSortBy() error {
+	return m.setSortBy(GetNextSortMode(m.config.SortBy))
+}
+
+// setSortBy switches to a sort order and saves
+func (m *Model) setSortBy(sortBy string) error {
+	if err := ValidateSortBy(sortBy); err != nil {
+		return err
+	}
+	m.recordHistory("sort order")
+	m.config.SortBy = sortBy
+	m.updateColleagueTimes()
+	return m.saveConfig()
+}
+
+// moveColleague swaps the colleague at display row cursor with its
+// neighbour delta rows away (-1 up, +1 down) in Config.Colleagues and
+// saves. Reordering is only meaningful in config order; other sort
+// orders would immediately put the row back.
+func (m *Model) moveColleague(cursor, delta int) error {
+	target := cursor + delta
+	if cursor < 0 || cursor >= len(m.colleagues) || target < 0 || target >= len(m.colleagues) {
+		return nil
+	}
+	if m.config.SortBy != SortByConfig {
+		return fmt.Errorf("reordering needs sort order %q (currently %q)", SortByConfig, m.config.SortBy)
+	}
+
+	i, j := m.colleagues[cursor].ConfigIndex, m.colleagues[target].ConfigIndex
+	m.recordHistory("move " + m.colleagueName(i))
+	m.config.Colleagues[i], m.config.Colleagues[j] = m.config.Colleagues[j], m.config.Colleagues[i]
+	if m.marked[i] != m.marked[j] {
+		// Marks follow the colleagues
+		m.toggleMark(cursor)
+		m.toggleMark(target)
+	}
+	m.updateColleagueTimes()
+	m.cursor = target
+	return m.saveConfig()
+}
+
+// cycleColorScheme switches to the next registered color scheme and saves
+func (m *Model) cycleColorScheme() error {
+	return m.setColorScheme(GetNextColorScheme(m.config.ColorScheme))
+}
+
+// setColorScheme switches to a registered color scheme and saves
+func (m *Model) setColorScheme(name string) error {
+	if _, ok := lookupColorScheme(name); !ok {
+		return fmt.Errorf("unknown color scheme %q (available: %s)", name, strings.Join(GetAvailableColorSchemes(), ", "))
+	}
+	m.recordHistory("color scheme")
+	m.config.ColorScheme = name
+	m.applyColorScheme()
+	return m.saveConfig()
+}
+
+// toggleTimelineMode switches between individual and shared bars and saves
+func (m *Model) toggleTimelineMode() error {
+	if m.config.TimelineMode == "individual" {
+		return m.setTimelineMode("shared")
+	}
+	return m.setTimelineMode("individual")
+}
+
+// setTimelineMode switches to "individual" or "shared" and saves
+func (m *Model) setTimelineMode(mode string) error {
+	if mode != "individual" && mode != "shared" {
+		return fmt.Errorf("unknown timeline mode %q (available: individual, shared)", mode)
+	}
+	m.recordHistory("timeline mode")
+	m.config.TimelineMode = mode
+	return m.saveConfig()
+}
+
 // updateSearchResults updates the search results based on current query
 func (m *Model) updateSearchResults() {
 	m.searchResults = SearchTimezones(m.searchQuery)
@@ -229,6 +552,7 @@ func (m *Model) addColleagueFromSearch(baseName string, result SearchResult) err
 	finalName := GetDisplayNameForColleague(baseName, result.City, m.searchQuery, m.config.LocationDisplayFormat)
 
 	colleague := newColleague(finalName, result.City.Timezone)
+	m.recordHistory("add " + finalName)
 
 	m.config.Colleagues = append(m.config.Colleagues, colleague)
 	m.updateColleagueTimes()
@@ -244,6 +568,7 @@ func (m *Model) editColleagueFromSearch(index int, baseName string, result Searc
 	// Use smart append logic to format the name
 	finalName := GetDisplayNameForColleague(baseName, result.City, m.searchQuery, m.config.LocationDisplayFormat)
 
+	m.recordHistory("edit " + m.colleagueName(index))
 	m.config.Colleagues[index].Name = finalName
 	m.config.Colleagues[index].Timezone = result.City.Timezone
 	m.updateColleagueTimes()
@@ -0,0 +1,329 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+)
+
+// MouseEnabled reports whether the mouse is captured (default on).
+// Turning it off gives the terminal's own text selection back.
+func (c Config) MouseEnabled() bool {
+	return c.Mouse == nil || *c.Mouse
+}
+
+// handleMouse handles mouse input: the wheel scrolls the list, the
+// timeline and search results; a click selects a row, and on a
+// timeline bar (also in the split view) scrubs to the time clicked; a
+// drag across the shared timeline selects a range
+func (m Model) handleMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	if msg.Action == tea.MouseActionPress && (msg.Button == tea.MouseButtonWheelUp || msg.Button == tea.MouseButtonWheelDown) {
+		m.scrollWheel(msg.Button == tea.MouseButtonWheelUp)
+		return m, nil
+	}
+	if msg.Button != tea.MouseButtonLeft && msg.Action != tea.MouseActionRelease {
+		return m, nil
+	}
+
+	switch m.inputMode {
+	case ModeNormal:
+		if m.splitActive() {
+			m.handleTimelineMouse(msg)
+		} else if msg.Action == tea.MouseActionPress {
+			m.clickRow(msg.Y)
+		}
+	case ModeTimeline:
+		m.handleTimelineMouse(msg)
+	case ModeRange:
+		// A click anywhere closes the range popup
+		if msg.Action == tea.MouseActionPress {
+			m.inputMode = m.returnMode
+		}
+	}
+	return m, nil
+}
+
+// scrollWheel scrolls the current view by a row
+func (m *Model) scrollWheel(up bool) {
+	switch m.inputMode {
+	case ModeNormal, ModeTimeline:
+		if up {
+			m.scrollOffset--
+		} else {
+			m.scrollOffset++
+		}
+		m.clampScroll()
+	case ModeSearchTimezone, ModeEditSearchTimezone:
+		// Moves the result cursor, as the arrow keys do
+		if up {
+			m.handleSearchNavigation(tea.KeyMsg{Type: tea.KeyUp})
+		} else {
+			m.handleSearchNavigation(tea.KeyMsg{Type: tea.KeyDown})
+		}
+	}
+}
+
+// rowAt returns the index into m.colleagues of the list or timeline
+// row drawn on screen line y, or -1. It follows the layout of View:
+// the header, your pinned row, the top scroll indicator, then the
+// visible rows.
+func (m Model) rowAt(y int) int {
+	var line int
+	switch m.viewMode() {
+	case ModeNormal:
+		line = wrappedHeight(m.renderHeader(), m.width)
+	case ModeTimeline, ModeRange:
+		line = wrappedHeight(m.renderTimelineHeader(), m.width) + 1
+	default:
+		return -1
+	}
+	line += m.meHeight() // Your pinned row isn't selectable
+	if m.scrollOffset > 0 {
+		line += 2 // Blank line and "▲ more above"
+	}
+	end := min(m.scrollOffset+m.visibleRows(), len(m.colleagues))
+	for i := m.scrollOffset; i < end; i++ {
+		height := m.rowHeight(i)
+		if y >= line && y < line+height {
+			return i
+		}
+		line += height
+	}
+	return -1
+}
+
+// rowHeight returns how many lines row i takes: timeline rows can
+// have a label line under their bar
+func (m Model) rowHeight(i int) int {
+	if m.viewMode() != ModeNormal && m.rowLabelsShown() && !m.colleagues[i].InvalidTimezone {
+		return 2
+	}
+	return 1
+}
+
+// clickRow selects the row on screen line y, if any; it returns the
+// row's index or -1
+func (m *Model) clickRow(y int) int {
+	i := m.rowAt(y)
+	if i >= 0 {
+		m.cursor = i
+		m.activateSelection()
+	}
+	return i
+}
+
+// barGeometry returns the screen column of the first cell of the
+// timeline bars and their width; width is 0 when no bars are shown
+func (m Model) barGeometry() (start, width int) {
+	if m.viewMode() == ModeTimeline {
+		nameWidth, timeWidth, barWidth := m.timelineLayout()
+		// Name, space, time, space and the opening bracket
+		return nameWidth + timeWidth + 3, barWidth
+	}
+	if l, barWidth, ok := m.splitLayout(); ok {
+		// List row, gap and the opening bracket
+		return l.rowWidth() + SplitGap + 1, barWidth
+	}
+	return 0, 0
+}
+
+// barCellAt returns the timeline bar cell at screen column x, or -1
+func (m Model) barCellAt(x int) int {
+	_, barWidth := m.barGeometry()
+	if cell := m.barColumn(x); cell >= 0 && cell < barWidth {
+		return cell
+	}
+	return -1
+}
+
+// barColumn returns the bar cell screen column x would be, were the
+// bar long enough: negative left of it
+func (m Model) barColumn(x int) int {
+	start, _ := m.barGeometry()
+	return x - start
+}
+
+// dragCell returns the bar cell a drag at screen column x reaches: past
+// the ends of the bar, the drag stays at its edge
+func (m Model) dragCell(x int) int {
+	_, barWidth := m.barGeometry()
+	return max(min(m.barColumn(x), barWidth-1), 0)
+}
+
+// handleTimelineMouse handles clicks and drags on the timeline. A
+// press on a bar starts a drag; releasing on the same cell scrubs to
+// it, and across cells of the shared timeline selects that range.
+func (m *Model) handleTimelineMouse(msg tea.MouseMsg) {
+	switch msg.Action {
+	case tea.MouseActionPress:
+		row := m.clickRow(msg.Y)
+		cell := m.barCellAt(msg.X)
+		// Shared bars all show the same window, so the overlap row and
+		// the labels under it work too
+		if cell < 0 || (row < 0 && m.config.TimelineMode != "shared") {
+			return
+		}
+		m.dragging = true
+		m.dragRow = row
+		m.dragFrom, m.dragTo = cell, cell
+
+	case tea.MouseActionMotion:
+		if m.dragging {
+			m.dragTo = m.dragCell(msg.X)
+		}
+
+	case tea.MouseActionRelease:
+		if !m.dragging {
+			return
+		}
+		m.dragging = false
+		m.dragTo = m.dragCell(msg.X)
+		if m.config.TimelineMode == "shared" && m.dragTo != m.dragFrom {
+			m.openRange()
+			return
+		}
+		m.scrubToCell(m.dragRow, m.dragTo)
+	}
+}
+
+// cellWindow returns the window the bar of row i shows: the colleague's
+// own in individual mode, the reference zone's in shared mode
+func (m Model) cellWindow(i int) timelineWindow {
+	if m.config.TimelineMode == "individual" && i >= 0 && i < len(m.colleagues) {
+		return m.windowAt(m.scrubbed(m.colleagues[i]).CurrentTime)
+	}
+	return m.windowAt(m.displayNow())
+}
+
+// scrubToCell scrubs the timeline to the start of cell of row i's bar
+func (m *Model) scrubToCell(i, cell int) {
+	if i >= 0 && m.colleagues[i].InvalidTimezone {
+		return
+	}
+	_, barWidth := m.barGeometry()
+	m.timeOffset = m.cellWindow(i).at(cell, barWidth).Sub(time.Now())
+}
+
+// dragRange returns the first and last cells of the dragged range
+func (m Model) dragRange() (int, int) {
+	return min(m.dragFrom, m.dragTo), max(m.dragFrom, m.dragTo)
+}
+
+// openRange shows the popup for the range dragged across the shared
+// timeline, from the start of its first cell to the end of its last
+func (m *Model) openRange() {
+	_, barWidth := m.barGeometry()
+	w := m.windowAt(m.displayNow())
+	first, last := m.dragRange()
+	m.rangeFrom = w.at(first, barWidth)
+	m.rangeTo = w.at(last+1, barWidth)
+	m.returnMode = m.inputMode
+	m.inputMode = ModeRange
+}
+
+// selectedCells marks the cells of a shared bar of barWidth showing w
+// that are being dragged across or are in the open range popup; nil
+// when there is no selection
+func (m Model) selectedCells(w timelineWindow, barWidth int) []bool {
+	switch {
+	case m.config.TimelineMode != "shared":
+		return nil
+	case m.dragging:
+		selected := make([]bool, barWidth)
+		first, last := m.dragRange()
+		for i := first; i <= last && i < barWidth; i++ {
+			selected[i] = true
+		}
+		return selected
+	case m.inputMode == ModeRange:
+		selected := make([]bool, barWidth)
+		for i := range selected {
+			t := w.at(i, barWidth)
+			selected[i] = !t.Before(m.rangeFrom) && t.Before(m.rangeTo)
+		}
+		return selected
+	}
+	return nil
+}
+
+// rangeStatus returns the status symbol for a colleague over the
+// selected range: working throughout, part of it, or not at all
+func rangeStatus(c Colleague, loc *time.Location, from, to time.Time) string {
+	working, steps := 0, 0
+	for t := from; t.Before(to); t = t.Add(15 * time.Minute) {
+		steps++
+		if barCharAt(c, t.In(loc)) == '█' {
+			working++
+		}
+	}
+	switch {
+	case working == 0:
+		return "○"
+	case working == steps:
+		return "●"
+	}
+	return "◐"
+}
+
+// renderRangePopup renders the popup for a range selected on the
+// shared timeline: each colleague's local start and end, with the day
+// where it isn't the reference zone's
+func (m Model) renderRangePopup() string {
+	scheme := getCurrentColorScheme(m.config.ColorScheme)
+	format := m.config.TimeFormat
+	from, to := m.rangeFrom, m.rangeTo
+
+	var b strings.Builder
+	b.WriteString(promptStyle.Render(fmt.Sprintf("%s %s – %s (%s)",
+		from.Format("Mon Jan 2"), FormatTimeShort(from, format), FormatTimeShort(to, format), formatCountdown(to.Sub(from)))))
+
+	rows := m.withMe()
+	nameWidth := 0
+	for _, ct := range rows {
+		nameWidth = max(nameWidth, runewidth.StringWidth(ct.Colleague.Name))
+	}
+	nameWidth = min(nameWidth, NameFieldWidth)
+	for _, ct := range rows {
+		b.WriteString("\n")
+		name := truncateOrPad(ct.Colleague.Name, nameWidth)
+		if ct.InvalidTimezone {
+			b.WriteString(invalidStyle.Render("⚠ " + name + "  invalid timezone"))
+			continue
+		}
+		loc := ct.CurrentTime.Location()
+		start, end := from.In(loc), to.In(loc)
+		startStr := FormatTimeShort(start, format)
+		if start.Format("2006-01-02") != from.Format("2006-01-02") {
+			startStr = start.Format("Mon ") + startStr
+		}
+		endStr := FormatTimeShort(end, format)
+		if end.Format("2006-01-02") != start.Format("2006-01-02") {
+			endStr = end.Format("Mon ") + endStr
+		}
+		status := rangeStatus(ct.Colleague, loc, from, to)
+		style := offHoursStyle
+		if status == "●" {
+			style = workingStyle
+		}
+		b.WriteString(style.Render(fmt.Sprintf("%s %s  %s – %s", status, name, startStr, endStr)))
+	}
+	b.WriteString("\n")
+	b.WriteString(dateStyle.Render("● working throughout • ◐ part of it • Esc close"))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(scheme.Primary).
+		Padding(0, 1).
+		Render(b.String())
+}
+
+// handleRangeMode handles keys while the range popup is open: any key
+// closes it
+func (m Model) handleRangeMode(tea.KeyMsg) (tea.Model, tea.Cmd) {
+	m.inputMode = m.returnMode
+	return m, nil
+}
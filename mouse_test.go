@@ -0,0 +1,196 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// mouseMsg returns a left-button mouse event at x, y
+func mouseMsg(action tea.MouseAction, x, y int) tea.MouseMsg {
+	return tea.MouseMsg{X: x, Y: y, Action: action, Button: tea.MouseButtonLeft}
+}
+
+// lineOf returns the screen line of the view showing s, or -1
+func lineOf(view, s string) int {
+	for i, line := range strings.Split(stripANSI(view), "\n") {
+		if strings.Contains(line, s) {
+			return i
+		}
+	}
+	return -1
+}
+
+// TestRowAt tests hit-testing rows against where View draws them
+func TestRowAt(t *testing.T) {
+	tests := []struct {
+		name      string
+		mode      InputMode
+		scroll    int
+		rowLabels string
+	}{
+		{"list", ModeNormal, 0, ""},
+		{"list scrolled", ModeNormal, 1, ""},
+		{"timeline", ModeTimeline, 0, ""},
+		{"timeline scrolled", ModeTimeline, 1, ""},
+		{"timeline with row labels", ModeTimeline, 0, RowLabelsOwn},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, _ := newReloadTestModel(t) // Alice (New York), Bob (London), Charlie (Tokyo)
+			m.width, m.height = 120, 30
+			m.inputMode = tt.mode
+			m.scrollOffset = tt.scroll
+			m.config.TimelineRowLabels = tt.rowLabels
+
+			view := m.View()
+			for i := tt.scroll; i < len(m.colleagues); i++ {
+				y := lineOf(view, m.colleagues[i].Colleague.Name)
+				if y < 0 {
+					t.Fatalf("%s not in view", m.colleagues[i].Colleague.Name)
+				}
+				if got := m.rowAt(y); got != i {
+					t.Errorf("rowAt(%d) = %d, want %d (%s)", y, got, i, m.colleagues[i].Colleague.Name)
+				}
+			}
+			if got := m.rowAt(0); got != -1 {
+				t.Errorf("rowAt(header) = %d, want -1", got)
+			}
+		})
+	}
+}
+
+func TestClickSelectsRow(t *testing.T) {
+	m, _ := newReloadTestModel(t)
+	m.width, m.height = 120, 30
+	y := lineOf(m.View(), "Charlie (Tokyo)")
+
+	next, _ := m.Update(mouseMsg(tea.MouseActionPress, 5, y))
+	m = next.(Model)
+	if m.cursor != 2 || !m.selectionActive {
+		t.Errorf("cursor = %d active %v, want 2 active", m.cursor, m.selectionActive)
+	}
+}
+
+func TestMouseWheel(t *testing.T) {
+	wheel := func(button tea.MouseButton) tea.MouseMsg {
+		return tea.MouseMsg{Action: tea.MouseActionPress, Button: button}
+	}
+	m, _ := newReloadTestModel(t)
+	m.config.Colleagues = append(m.config.Colleagues, Colleague{Name: "Dana", Timezone: "UTC"})
+	m.updateColleagueTimes()
+	m.width, m.height = 120, 8 // Room for MinVisible rows of 4
+
+	next, _ := m.Update(wheel(tea.MouseButtonWheelDown))
+	m = next.(Model)
+	if m.scrollOffset != 1 {
+		t.Errorf("wheel down: scrollOffset = %d, want 1", m.scrollOffset)
+	}
+	for range 3 {
+		next, _ = m.Update(wheel(tea.MouseButtonWheelUp))
+		m = next.(Model)
+	}
+	if m.scrollOffset != 0 {
+		t.Errorf("wheel up: scrollOffset = %d, want 0", m.scrollOffset)
+	}
+
+	// Search results: the wheel moves the result cursor
+	m.enterSearchMode()
+	m.searchQuery = "a"
+	m.updateSearchResults()
+	next, _ = m.Update(wheel(tea.MouseButtonWheelDown))
+	m = next.(Model)
+	if m.searchCursor != 1 {
+		t.Errorf("wheel down in search: searchCursor = %d, want 1", m.searchCursor)
+	}
+}
+
+func TestClickBarScrubs(t *testing.T) {
+	m, _ := newReloadTestModel(t)
+	m.localTimezone = time.UTC
+	m.width, m.height = 120, 30
+	m.inputMode = ModeTimeline
+	m.config.TimelineMode = "shared"
+
+	nameWidth, timeWidth, barWidth := m.timelineLayout()
+	y := lineOf(m.View(), "Bob (London)")
+	x := nameWidth + timeWidth + 3 + barWidth/2 // Noon
+	for _, action := range []tea.MouseAction{tea.MouseActionPress, tea.MouseActionRelease} {
+		next, _ := m.Update(mouseMsg(action, x, y))
+		m = next.(Model)
+	}
+
+	now := m.displayNow()
+	if now.Hour() != 12 || now.Minute() != 0 {
+		t.Errorf("scrubbed to %v, want 12:00", now)
+	}
+	if m.cursor != 1 {
+		t.Errorf("cursor = %d, want Bob's row", m.cursor)
+	}
+}
+
+func TestDragSelectsRange(t *testing.T) {
+	m, _ := newReloadTestModel(t)
+	m.localTimezone = time.UTC
+	m.width, m.height = 120, 40
+	m.inputMode = ModeTimeline
+	m.config.TimelineMode = "shared"
+
+	nameWidth, timeWidth, barWidth := m.timelineLayout()
+	perHour := barWidth / 24
+	bar := nameWidth + timeWidth + 3
+	y := lineOf(m.View(), "Alice (New York)")
+
+	// Drag from 14:00 to the end of the 16:00 hour
+	for _, msg := range []tea.MouseMsg{
+		mouseMsg(tea.MouseActionPress, bar+14*perHour, y),
+		mouseMsg(tea.MouseActionMotion, bar+15*perHour, y+1),
+		mouseMsg(tea.MouseActionRelease, bar+17*perHour-1, y+1),
+	} {
+		next, _ := m.Update(msg)
+		m = next.(Model)
+	}
+	if m.inputMode != ModeRange {
+		t.Fatalf("inputMode = %v, want ModeRange", m.inputMode)
+	}
+	if got := m.rangeTo.Sub(m.rangeFrom); got != 3*time.Hour || m.rangeFrom.Hour() != 14 {
+		t.Errorf("range = %v + %v, want 14:00 + 3h", m.rangeFrom, got)
+	}
+
+	view := stripANSI(m.View())
+	// Tokyo is 9 hours ahead: 23:00 to 02:00 the next day
+	tomorrow := m.displayNow().AddDate(0, 0, 1).Format("Mon")
+	for _, want := range []string{"14:00 – 17:00 (3h)", "Alice (New York)", "Charlie (Tokyo)", "23:00 – " + tomorrow + " 02:00"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("popup missing %q:\n%s", want, view)
+		}
+	}
+	if m.timeOffset != 0 {
+		t.Errorf("range selection scrubbed the timeline by %v", m.timeOffset)
+	}
+
+	next, _ := m.Update(keyMsg("x"))
+	if m = next.(Model); m.inputMode != ModeTimeline {
+		t.Errorf("after a key: inputMode = %v, want ModeTimeline", m.inputMode)
+	}
+}
+
+func TestRangeStatus(t *testing.T) {
+	c := Colleague{Name: "A"} // 9-17
+	monday := func(hour int) time.Time { return time.Date(2025, 1, 20, hour, 0, 0, 0, time.UTC) }
+	tests := []struct {
+		from, to int
+		want     string
+	}{
+		{10, 12, "●"},
+		{16, 18, "◐"},
+		{18, 20, "○"},
+	}
+	for _, tt := range tests {
+		if got := rangeStatus(c, time.UTC, monday(tt.from), monday(tt.to)); got != tt.want {
+			t.Errorf("rangeStatus(%d-%d) = %s, want %s", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
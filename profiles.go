@@ -0,0 +1,93 @@
+package main
+
+import (
+	"fmt"
+	"maps"
+	"sort"
+)
+
+// DefaultProfile is the name of the roster stored at the top level of
+// the config (colleagues, color_scheme, timeline_mode)
+const DefaultProfile = "default"
+
+// Profile is a named roster from the config's profiles section. Empty
+// ColorScheme/TimelineMode inherit the top-level values.
+type Profile struct {
+	ColorScheme  string      `yaml:"color_scheme,omitempty"`
+	TimelineMode string      `yaml:"timeline_mode,omitempty"`
+	Colleagues   []Colleague `yaml:"colleagues"`
+}
+
+// ProfileNames returns the default profile followed by the named
+// profiles in alphabetical order
+func (c Config) ProfileNames() []string {
+	names := make([]string, 0, len(c.Profiles))
+	for name := range c.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return append([]string{DefaultProfile}, names...)
+}
+
+// HasProfile reports whether name is the default or a named profile
+func (c Config) HasProfile(name string) bool {
+	if name == DefaultProfile {
+		return true
+	}
+	_, ok := c.Profiles[name]
+	return ok
+}
+
+// forProfile returns the config as seen from one profile: the
+// profile's colleagues, color scheme and timeline mode replace the
+// top-level ones, and everything else (time format, keys, custom
+// schemes) is shared. The view carries no Profiles; withProfile merges
+// it back into the full config.
+func (c Config) forProfile(name string) (Config, error) {
+	view := c
+	view.Profiles = nil
+	if name == DefaultProfile {
+		return view, nil
+	}
+
+	p, ok := c.Profiles[name]
+	if !ok {
+		return Config{}, fmt.Errorf("unknown profile %q (available: %v)", name, c.ProfileNames())
+	}
+	view.Colleagues = p.Colleagues
+	if p.ColorScheme != "" {
+		view.ColorScheme = p.ColorScheme
+	}
+	if p.TimelineMode != "" {
+		view.TimelineMode = p.TimelineMode
+	}
+	return view, nil
+}
+
+// withProfile merges a profile view (from forProfile, possibly edited)
+// back into the full config, so a save only changes that profile's
+// roster plus the shared settings. A named profile keeps inheriting the
+// top-level scheme/mode until the view sets a different one.
+func (c Config) withProfile(name string, view Config) Config {
+	full := view
+	full.Profiles = c.Profiles
+	if name == DefaultProfile {
+		return full
+	}
+
+	// The top-level roster belongs to the default profile: keep it
+	full.Colleagues = c.Colleagues
+	full.ColorScheme = c.ColorScheme
+	full.TimelineMode = c.TimelineMode
+
+	p := Profile{Colleagues: view.Colleagues}
+	if view.ColorScheme != c.ColorScheme {
+		p.ColorScheme = view.ColorScheme
+	}
+	if view.TimelineMode != c.TimelineMode {
+		p.TimelineMode = view.TimelineMode
+	}
+	full.Profiles = maps.Clone(c.Profiles)
+	full.Profiles[name] = p
+	return full
+}
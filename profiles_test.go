@@ -0,0 +1,190 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const profilesYAML = `color_scheme: nord
+timeline_mode: individual
+colleagues:
+  - name: "Alice"
+    timezone: "America/New_York"
+profiles:
+  family:
+    timeline_mode: shared
+    colleagues:
+      - name: "Mum"
+        timezone: "Europe/London"
+      - name: "Kid"
+        timezone: "Australia/Sydney"
+  customer:
+    color_scheme: dark
+    colleagues:
+      - name: "Yuki"
+        timezone: "Asia/Tokyo"
+`
+
+func newProfilesTestModel(t *testing.T) (Model, string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(profilesYAML), 0644); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+	config, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig failed: %v", err)
+	}
+	return NewModel(config, path), path
+}
+
+func TestProfileNames(t *testing.T) {
+	m, _ := newProfilesTestModel(t)
+	names := m.fullConfig.ProfileNames()
+	want := []string{"default", "customer", "family"}
+	if len(names) != len(want) {
+		t.Fatalf("ProfileNames() = %v, want %v", names, want)
+	}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Errorf("ProfileNames()[%d] = %q, want %q", i, names[i], want[i])
+		}
+	}
+}
+
+func TestSwitchProfile(t *testing.T) {
+	m, _ := newProfilesTestModel(t)
+
+	if err := m.switchProfile("family"); err != nil {
+		t.Fatalf("switchProfile failed: %v", err)
+	}
+	if len(m.colleagues) != 2 || m.colleagues[0].Colleague.Name != "Mum" {
+		t.Errorf("Expected family roster, got %+v", m.config.Colleagues)
+	}
+	if m.config.TimelineMode != "shared" {
+		t.Errorf("TimelineMode = %q, want shared from profile", m.config.TimelineMode)
+	}
+	// Unset in the profile: inherited from the top level
+	if m.config.ColorScheme != "nord" {
+		t.Errorf("ColorScheme = %q, want inherited nord", m.config.ColorScheme)
+	}
+
+	if err := m.switchProfile("customer"); err != nil {
+		t.Fatalf("switchProfile failed: %v", err)
+	}
+	if m.config.ColorScheme != "dark" || m.config.TimelineMode != "individual" {
+		t.Errorf("customer profile: scheme %q mode %q, want dark/individual",
+			m.config.ColorScheme, m.config.TimelineMode)
+	}
+
+	if err := m.switchProfile("nope"); err == nil {
+		t.Error("Expected error for unknown profile")
+	}
+	if m.profile != "customer" {
+		t.Errorf("Failed switch must keep the active profile, got %q", m.profile)
+	}
+}
+
+func TestProfileSavesAreScoped(t *testing.T) {
+	m, path := newProfilesTestModel(t)
+	if err := m.switchProfile("family"); err != nil {
+		t.Fatalf("switchProfile failed: %v", err)
+	}
+
+	if err := m.deleteColleague(0); err != nil {
+		t.Fatalf("deleteColleague failed: %v", err)
+	}
+	m.config.TimeFormat = "12h" // Shared setting
+	if err := m.saveConfig(); err != nil {
+		t.Fatalf("saveConfig failed: %v", err)
+	}
+
+	loaded, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig failed: %v", err)
+	}
+	if got := loaded.Profiles["family"].Colleagues; len(got) != 1 || got[0].Name != "Kid" {
+		t.Errorf("family roster = %+v, want only Kid", got)
+	}
+	if len(loaded.Colleagues) != 1 || loaded.Colleagues[0].Name != "Alice" {
+		t.Errorf("default roster changed: %+v", loaded.Colleagues)
+	}
+	if len(loaded.Profiles["customer"].Colleagues) != 1 {
+		t.Errorf("customer roster changed: %+v", loaded.Profiles["customer"])
+	}
+	if loaded.TimelineMode != "individual" || loaded.Profiles["family"].TimelineMode != "shared" {
+		t.Errorf("timeline modes leaked across profiles: top %q family %q",
+			loaded.TimelineMode, loaded.Profiles["family"].TimelineMode)
+	}
+	// Still inheriting: the profile must not pin the top-level scheme
+	if loaded.Profiles["family"].ColorScheme != "" {
+		t.Errorf("family color_scheme = %q, want inherited (empty)", loaded.Profiles["family"].ColorScheme)
+	}
+	if loaded.TimeFormat != "12h" {
+		t.Errorf("Shared TimeFormat = %q, want 12h", loaded.TimeFormat)
+	}
+}
+
+func TestProfileReloadKeepsActiveProfile(t *testing.T) {
+	m, path := newProfilesTestModel(t)
+	if err := m.switchProfile("customer"); err != nil {
+		t.Fatalf("switchProfile failed: %v", err)
+	}
+
+	edited := profilesYAML + `      - name: "Kenji"
+        timezone: "Asia/Tokyo"
+`
+	writeConfigWithMtime(t, path, edited, time.Now().Add(time.Hour))
+	m.maybeReloadConfig()
+
+	if m.profile != "customer" || len(m.config.Colleagues) != 2 {
+		t.Errorf("Expected reload into customer with 2 colleagues, got %q with %+v", m.profile, m.config.Colleagues)
+	}
+
+	// Removing the active profile falls back to the default roster
+	writeConfigWithMtime(t, path, "colleagues: []\n", time.Now().Add(2*time.Hour))
+	m.maybeReloadConfig()
+	if m.profile != DefaultProfile {
+		t.Errorf("profile = %q after removal, want default", m.profile)
+	}
+	if m.errorMsg == "" {
+		t.Error("Expected a message explaining the profile fallback")
+	}
+}
+
+func TestProfileSwitcherKeys(t *testing.T) {
+	m, _ := newProfilesTestModel(t)
+	m.inputMode = ModeTimeline
+
+	next, _ := m.handleTimelineMode(keyMsg("p"))
+	m = next.(Model)
+	if m.inputMode != ModeProfiles {
+		t.Fatalf("Expected profile switcher, got mode %v", m.inputMode)
+	}
+
+	// default -> customer -> family
+	next, _ = m.handleProfilesMode(keyMsg("down"))
+	m = next.(Model)
+	next, _ = m.handleProfilesMode(keyMsg("down"))
+	m = next.(Model)
+	next, _ = m.handleProfilesMode(tea.KeyMsg{Type: tea.KeyEnter})
+	m = next.(Model)
+
+	if m.profile != "family" {
+		t.Errorf("profile = %q, want family", m.profile)
+	}
+	if m.inputMode != ModeTimeline {
+		t.Errorf("Expected to return to timeline mode, got %v", m.inputMode)
+	}
+}
+
+func TestDefaultProfileNameReserved(t *testing.T) {
+	data := []byte("profiles:\n  default:\n    colleagues: []\n")
+	if _, err := parseConfig(data); err == nil {
+		t.Error("Expected error for a profile named default")
+	}
+}
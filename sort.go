@@ -0,0 +1,77 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Sort modes for the colleague list and timeline (Config.SortBy)
+const (
+	SortByConfig = "config" // Config file order (reorderable in-app)
+	SortByOffset = "offset" // Westmost first
+	SortByName   = "name"
+	SortByStatus = "status" // Working, then off hours, then weekend
+)
+
+// SortModes lists the sort modes in cycling order
+var SortModes = []string{SortByConfig, SortByOffset, SortByName, SortByStatus}
+
+// ValidateSortBy checks that mode is a known sort mode
+func ValidateSortBy(mode string) error {
+	for _, m := range SortModes {
+		if m == mode {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown sort_by %q (available: %s)", mode, strings.Join(SortModes, ", "))
+}
+
+// GetNextSortMode returns the sort mode after current, wrapping around
+func GetNextSortMode(current string) string {
+	for i, m := range SortModes {
+		if m == current {
+			return SortModes[(i+1)%len(SortModes)]
+		}
+	}
+	return SortByConfig
+}
+
+// sortColleagueTimes orders display rows in place. The sort is stable,
+// so ties (and SortByConfig) keep config order; rows with an invalid
+// timezone have no time to compare and always go last.
+func sortColleagueTimes(cts []ColleagueTime, mode string) {
+	if mode == SortByConfig || mode == "" {
+		return
+	}
+	sort.SliceStable(cts, func(i, j int) bool {
+		a, b := cts[i], cts[j]
+		if a.InvalidTimezone || b.InvalidTimezone {
+			return !a.InvalidTimezone && b.InvalidTimezone
+		}
+
+		switch mode {
+		case SortByOffset:
+			_, ao := a.CurrentTime.Zone()
+			_, bo := b.CurrentTime.Zone()
+			return ao < bo
+		case SortByName:
+			return strings.ToLower(a.Colleague.Name) < strings.ToLower(b.Colleague.Name)
+		case SortByStatus:
+			return statusRank(a) < statusRank(b)
+		}
+		return false
+	})
+}
+
+// statusRank orders colleagues for SortByStatus
+func statusRank(ct ColleagueTime) int {
+	switch {
+	case ct.IsWorkingTime:
+		return 0
+	case !ct.IsWeekend:
+		return 1
+	default:
+		return 2
+	}
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSortColleagueTimes(t *testing.T) {
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	ny, _ := time.LoadLocation("America/New_York")
+	now := time.Now()
+
+	rows := func() []ColleagueTime {
+		return []ColleagueTime{
+			{Colleague: Colleague{Name: "yuki"}, ConfigIndex: 0, CurrentTime: now.In(tokyo), IsWeekend: true},
+			{Colleague: Colleague{Name: "Broken"}, ConfigIndex: 1, InvalidTimezone: true},
+			{Colleague: Colleague{Name: "Alice"}, ConfigIndex: 2, CurrentTime: now.In(ny)},
+			{Colleague: Colleague{Name: "Bob"}, ConfigIndex: 3, CurrentTime: now.In(time.UTC), IsWorkingTime: true},
+		}
+	}
+
+	tests := []struct {
+		mode string
+		want []int // ConfigIndex order
+	}{
+		{SortByConfig, []int{0, 1, 2, 3}},
+		{SortByOffset, []int{2, 3, 0, 1}},
+		{SortByName, []int{2, 3, 0, 1}},
+		{SortByStatus, []int{3, 2, 0, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			cts := rows()
+			sortColleagueTimes(cts, tt.mode)
+			for i, want := range tt.want {
+				if cts[i].ConfigIndex != want {
+					t.Errorf("row %d = %s (config %d), want config %d",
+						i, cts[i].Colleague.Name, cts[i].ConfigIndex, want)
+				}
+			}
+		})
+	}
+}
+
+func TestGetNextSortMode(t *testing.T) {
+	mode := SortByConfig
+	for range SortModes {
+		mode = GetNextSortMode(mode)
+	}
+	if mode != SortByConfig {
+		t.Errorf("Cycling all modes ended on %q, want %q", mode, SortByConfig)
+	}
+	if got := GetNextSortMode("bogus"); got != SortByConfig {
+		t.Errorf("GetNextSortMode(bogus) = %q, want %q", got, SortByConfig)
+	}
+}
+
+func TestInvalidSortByRejected(t *testing.T) {
+	if _, err := parseConfig([]byte("sort_by: shoe_size\n")); err == nil {
+		t.Error("Expected error for unknown sort_by")
+	}
+}
+
+func TestMoveColleague(t *testing.T) {
+	m, path := newReloadTestModel(t) // Alice, Bob, Charlie
+	m.cursor = 0
+	m.selectionActive = true
+
+	next, _ := m.handleNormalMode(keyMsg("J"))
+	m = next.(Model)
+	if m.errorMsg != "" {
+		t.Fatalf("move failed: %s", m.errorMsg)
+	}
+	if m.config.Colleagues[1].Name != "Alice (New York)" || m.cursor != 1 {
+		t.Errorf("Expected Alice moved to row 1 with the cursor, got %+v cursor %d", m.config.Colleagues, m.cursor)
+	}
+	for i, ct := range m.colleagues {
+		if ct.ConfigIndex != i {
+			t.Errorf("row %d has ConfigIndex %d", i, ct.ConfigIndex)
+		}
+	}
+
+	loaded, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig failed: %v", err)
+	}
+	if loaded.Colleagues[0].Name != "Bob (London)" {
+		t.Errorf("Saved order starts with %q, want Bob", loaded.Colleagues[0].Name)
+	}
+
+	// Moving past either end is a no-op
+	if err := m.moveColleague(0, -1); err != nil {
+		t.Errorf("moveColleague at top: %v", err)
+	}
+	if m.config.Colleagues[0].Name != "Bob (London)" {
+		t.Errorf("Move past the top changed the order: %+v", m.config.Colleagues)
+	}
+
+	// Only config order can be rearranged
+	m.config.SortBy = SortByName
+	if err := m.moveColleague(1, 1); err == nil {
+		t.Error("Expected error moving while sorted by name")
+	}
+}
+
+func TestSortFollowsSelection(t *testing.T) {
+	m, _ := newReloadTestModel(t) // Alice, Bob, Charlie
+	m.cursor = 2                  // Charlie
+	m.selectionActive = true
+
+	for m.config.SortBy != SortByOffset {
+		if err := m.cycleSortBy(); err != nil {
+			t.Fatalf("cycleSortBy failed: %v", err)
+		}
+	}
+	if got := m.colleagues[m.cursor].Colleague.Name; got != "Charlie (Tokyo)" {
+		t.Errorf("Selection moved to %q, want it to follow Charlie", got)
+	}
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// SplitGap is the space between the list and the bars in the split view
+const SplitGap = 1
+
+// splitLayout returns the list layout and bar width of the split view,
+// where each list row is followed by its timeline bar. ok is false when
+// the split view is off or the terminal is too narrow for the list next
+// to a bar of IdealBarWidth; the list view then shows on its own.
+func (m Model) splitLayout() (l listLayout, barWidth int, ok bool) {
+	if !m.config.SplitView || m.width <= 0 {
+		return listLayout{}, 0, false
+	}
+	// Gap and the bar's brackets
+	chrome := SplitGap + 2
+
+	// The list at its natural width if the bar still fits; otherwise
+	// fitted into what the ideal bar leaves
+	l = m.fitListLayout(0)
+	if available := m.width - l.rowWidth() - chrome; available >= IdealBarWidth {
+		return l, m.barWidthFor(available), true
+	}
+	listWidth := m.width - chrome - IdealBarWidth
+	l = m.fitListLayout(listWidth)
+	if l.rowWidth() > listWidth {
+		return listLayout{}, 0, false
+	}
+	return l, IdealBarWidth, true
+}
+
+// splitActive reports whether the list view shows the timeline bars
+func (m Model) splitActive() bool {
+	_, _, ok := m.splitLayout()
+	return ok
+}
+
+// toggleSplitView turns the split view on or off and saves
+func (m *Model) toggleSplitView() error {
+	m.recordHistory("split view")
+	m.config.SplitView = !m.config.SplitView
+	if err := m.saveConfig(); err != nil {
+		return err
+	}
+	if m.config.SplitView && !m.splitActive() {
+		m.setStatus("split view: the terminal is too narrow, showing the list only")
+	}
+	m.clampScroll()
+	return nil
+}
+
+// splitLabel describes the split view setting for the footer
+func (m Model) splitLabel() string {
+	switch {
+	case !m.config.SplitView:
+		return "off"
+	case !m.splitActive():
+		return "on (too narrow)"
+	}
+	return "on"
+}
+
+// renderSplitRow appends row i's timeline bar to its list row, padded
+// to the list's width so the bars line up
+func (m Model) renderSplitRow(row string, ct ColleagueTime, l listLayout, barWidth int) string {
+	if ct.InvalidTimezone {
+		return row
+	}
+	padding := max(l.rowWidth()-lipgloss.Width(row), 0)
+	bar := m.renderSharedBar(ct, barWidth)
+	if m.config.TimelineMode == "individual" {
+		bar = m.renderIndividualBar(ct, barWidth)
+	}
+	return row + strings.Repeat(" ", padding+SplitGap) + bar
+}
+
+// renderSplitExtras renders what goes under the split view's rows: the
+// team-overlap bar in shared mode, then the hour labels
+func (m Model) renderSplitExtras() string {
+	l, barWidth, ok := m.splitLayout()
+	if !ok {
+		return ""
+	}
+	listWidth := l.rowWidth()
+
+	var b strings.Builder
+	if m.config.TimelineMode == "shared" {
+		if label, bar, working, total := m.renderOverlapBar(barWidth); bar != "" {
+			summary := fmt.Sprintf("    %s  %d/%d now", label, working, total)
+			b.WriteString(offHoursStyle.Render(truncateOrPad(summary, listWidth)))
+			b.WriteString(strings.Repeat(" ", SplitGap))
+			b.WriteString(bar)
+			b.WriteString("\n")
+		}
+	}
+	relative := m.config.TimelineMode != "shared" && m.config.CentersTimeline()
+	b.WriteString(m.renderHourLabels(m.windowAt(m.displayNow()), barWidth, listWidth+SplitGap, relative))
+	b.WriteString("\n")
+	return b.String()
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mattn/go-runewidth"
+)
+
+func TestSplitLayout(t *testing.T) {
+	tests := []struct {
+		name  string
+		width int
+		split bool
+	}{
+		{"wide", 160, true},
+		{"list fitted beside the bar", 90, true},
+		{"too narrow", 60, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, _ := newReloadTestModel(t) // Alice (New York), Bob (London), Charlie (Tokyo)
+			m.width, m.height = tt.width, 20
+			m.config.SplitView = true
+
+			if got := m.splitActive(); got != tt.split {
+				t.Fatalf("splitActive() = %v, want %v", got, tt.split)
+			}
+			lines := strings.Split(strings.TrimRight(stripANSI(m.View()), "\n"), "\n")
+			if len(lines) > m.height {
+				t.Errorf("view is %d lines, want at most %d", len(lines), m.height)
+			}
+
+			start, barWidth := m.barGeometry()
+			for _, ct := range m.colleagues {
+				y := lineOf(m.View(), ct.Colleague.Name)
+				open := strings.Index(lines[y], "[")
+				if hasBar := open >= 0; hasBar != tt.split {
+					t.Errorf("%s: bar shown = %v, want %v", ct.Colleague.Name, hasBar, tt.split)
+				}
+				if !tt.split {
+					continue
+				}
+				// Display columns: the DST column's ⚡ is two wide
+				if col, bar := runewidth.StringWidth(lines[y][:open]), runewidth.StringWidth(lines[y][open:]); col != start-1 || bar != barWidth+2 {
+					t.Errorf("%s: bar at column %d, %d wide; want %d, %d:\n%s", ct.Colleague.Name, col+1, bar-2, start, barWidth, lines[y])
+				}
+			}
+			if got := m.fitListLayout(m.width).rowWidth(); !tt.split && got > m.width {
+				t.Errorf("list row width %d over the terminal's %d", got, m.width)
+			}
+		})
+	}
+}
+
+func TestSplitScrub(t *testing.T) {
+	m, path := newReloadTestModel(t)
+	m.width, m.height = 160, 30
+
+	// Without the split view the arrow keys don't scrub the list
+	next, _ := m.Update(tea.KeyMsg{Type: tea.KeyRight})
+	if m = next.(Model); m.timeOffset != 0 {
+		t.Fatalf("right in the list view scrubbed by %v", m.timeOffset)
+	}
+
+	next, _ = m.Update(keyMsg("v"))
+	m = next.(Model)
+	if !m.config.SplitView {
+		t.Fatal("v didn't turn the split view on")
+	}
+	loaded, err := LoadConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !loaded.SplitView {
+		t.Error("split view not saved")
+	}
+
+	before := m.scrubbed(m.colleagues[0]).CurrentTime
+	next, _ = m.Update(tea.KeyMsg{Type: tea.KeyRight})
+	m = next.(Model)
+	if m.timeOffset != ScrubStep {
+		t.Fatalf("timeOffset = %v, want %v", m.timeOffset, ScrubStep)
+	}
+	// The list's times follow the scrub
+	after := m.scrubbed(m.colleagues[0]).CurrentTime
+	view := stripANSI(m.View())
+	if !strings.Contains(view, FormatTime(after, m.config.TimeFormat)) || after.Sub(before) < ScrubStep {
+		t.Errorf("list shows no time scrubbed from %v:\n%s", before, view)
+	}
+	if !strings.Contains(view, "⏩ scrubbed +1h") {
+		t.Errorf("header doesn't show the scrub:\n%s", view)
+	}
+
+	// Esc goes back to now before anything else
+	next, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEscape})
+	if m = next.(Model); m.timeOffset != 0 || cmd != nil {
+		t.Errorf("esc: timeOffset = %v, quit %v; want back to now", m.timeOffset, cmd != nil)
+	}
+}
+
+func TestSplitClickScrubs(t *testing.T) {
+	m, _ := newReloadTestModel(t)
+	m.localTimezone = time.UTC
+	m.width, m.height = 160, 30
+	m.config.SplitView = true
+	m.config.TimelineMode = "shared"
+
+	start, barWidth := m.barGeometry()
+	y := lineOf(m.View(), "Charlie (Tokyo)")
+	for _, action := range []tea.MouseAction{tea.MouseActionPress, tea.MouseActionRelease} {
+		next, _ := m.Update(mouseMsg(action, start+barWidth/2, y)) // Noon
+		m = next.(Model)
+	}
+
+	if now := m.displayNow(); now.Hour() != 12 || now.Minute() != 0 {
+		t.Errorf("scrubbed to %v, want 12:00", now)
+	}
+	if m.cursor != 2 || m.inputMode != ModeNormal {
+		t.Errorf("cursor = %d mode %v, want Charlie's row in the list", m.cursor, m.inputMode)
+	}
+
+	// A drag opens the range popup over the list, and closing it goes
+	// back to the list
+	m.timeOffset = 0
+	for _, msg := range []tea.MouseMsg{
+		mouseMsg(tea.MouseActionPress, start+10, y),
+		mouseMsg(tea.MouseActionRelease, start+20, y),
+	} {
+		next, _ := m.Update(msg)
+		m = next.(Model)
+	}
+	if m.inputMode != ModeRange || !strings.Contains(stripANSI(m.View()), "working throughout") {
+		t.Fatalf("inputMode = %v, want the range popup", m.inputMode)
+	}
+	next, _ := m.Update(keyMsg("x"))
+	if m = next.(Model); m.inputMode != ModeNormal {
+		t.Errorf("after a key: inputMode = %v, want ModeNormal", m.inputMode)
+	}
+}
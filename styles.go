@@ -28,18 +28,14 @@ type ColorScheme struct {
 }
 
 var (
-	// Color palette
-	primaryColor = lipgloss.Color("86")  // Cyan
-	successColor = lipgloss.Color("42")  // Green
-	warningColor = lipgloss.Color("214") // Orange
-	errorColor   = lipgloss.Color("196") // Red
-	mutedColor   = lipgloss.Color("240") // Gray
-	weekendColor = lipgloss.Color("141") // Purple
+	// UI styles. Colors start from the classic scheme and are replaced
+	// by applyUIColorScheme whenever the active scheme changes, so the
+	// whole UI (not just the timeline) follows the selected scheme.
 
 	// Header style
 	headerStyle = lipgloss.NewStyle().
 			Bold(true).
-			Foreground(primaryColor).
+			Foreground(classicScheme.Primary).
 			MarginBottom(1)
 
 	// Normal colleague row
@@ -49,57 +45,75 @@ var (
 	// Selected colleague row
 	selectedRowStyle = lipgloss.NewStyle().
 				PaddingLeft(1).
-				Foreground(primaryColor).
+				Foreground(classicScheme.Primary).
 				Bold(true)
 
 	// Working hours indicator
 	workingStyle = lipgloss.NewStyle().
-			Foreground(successColor).
+			Foreground(classicScheme.Success).
 			Bold(true)
 
 	// Off-hours indicator
 	offHoursStyle = lipgloss.NewStyle().
-			Foreground(mutedColor)
+			Foreground(classicScheme.Muted)
 
 	// Weekend indicator
 	weekendStyle = lipgloss.NewStyle().
-			Foreground(weekendColor)
+			Foreground(classicScheme.WeekendTint)
 
 	// Offset style
 	offsetStyle = lipgloss.NewStyle().
-			Foreground(warningColor)
+			Foreground(classicScheme.Warning)
 
 	// Date style
 	dateStyle = lipgloss.NewStyle().
-			Foreground(mutedColor).
+			Foreground(classicScheme.Muted).
 			Italic(true)
 
 	// Footer/help style
 	footerStyle = lipgloss.NewStyle().
-			Foreground(mutedColor).
+			Foreground(classicScheme.Muted).
 			MarginTop(1)
 
 	// Error message style
 	errorStyle = lipgloss.NewStyle().
-			Foreground(errorColor).
+			Foreground(classicScheme.Error).
 			Bold(true).
 			MarginTop(1)
 
 	// Invalid colleague entry style (no margin, used inline in rows)
 	invalidStyle = lipgloss.NewStyle().
-			Foreground(errorColor)
+			Foreground(classicScheme.Error)
 
 	// Input prompt style
 	promptStyle = lipgloss.NewStyle().
-			Foreground(primaryColor).
+			Foreground(classicScheme.Primary).
 			Bold(true)
 
 	// Help text style
 	helpStyle = lipgloss.NewStyle().
-			Foreground(mutedColor).
+			Foreground(classicScheme.Muted).
 			Padding(1)
 )
 
+// applyUIColorScheme recolors the global UI styles from a scheme.
+// Only foregrounds change; layout properties (margins, padding, bold)
+// stay as declared above.
+func applyUIColorScheme(scheme ColorScheme) {
+	headerStyle = headerStyle.Foreground(scheme.Primary)
+	selectedRowStyle = selectedRowStyle.Foreground(scheme.Primary)
+	promptStyle = promptStyle.Foreground(scheme.Primary)
+	workingStyle = workingStyle.Foreground(scheme.Success)
+	offHoursStyle = offHoursStyle.Foreground(scheme.Muted)
+	weekendStyle = weekendStyle.Foreground(scheme.WeekendTint)
+	offsetStyle = offsetStyle.Foreground(scheme.Warning)
+	dateStyle = dateStyle.Foreground(scheme.Muted)
+	footerStyle = footerStyle.Foreground(scheme.Muted)
+	errorStyle = errorStyle.Foreground(scheme.Error)
+	invalidStyle = invalidStyle.Foreground(scheme.Error)
+	helpStyle = helpStyle.Foreground(scheme.Muted)
+}
+
 // Color scheme definitions
 var (
 	// Classic - Vibrant colors with true color support
@@ -212,21 +226,47 @@ var (
 	}
 )
 
+// customColorSchemes holds the user-defined schemes from the config's
+// color_schemes section, keyed by name. Replaced wholesale by
+// setCustomColorSchemes on every (re)load.
+var customColorSchemes = map[string]ColorScheme{}
+
+// setCustomColorSchemes replaces the registered user-defined schemes
+func setCustomColorSchemes(schemes map[string]ColorScheme) {
+	customColorSchemes = make(map[string]ColorScheme, len(schemes))
+	for name, scheme := range schemes {
+		customColorSchemes[name] = scheme
+	}
+}
+
+// lookupColorScheme returns a built-in or user-defined scheme by name
+func lookupColorScheme(schemeName string) (ColorScheme, bool) {
+	if scheme, exists := colorSchemes[schemeName]; exists {
+		return scheme, true
+	}
+	scheme, exists := customColorSchemes[schemeName]
+	return scheme, exists
+}
+
 // getCurrentColorScheme returns the color scheme by name, or classic as fallback
 func getCurrentColorScheme(schemeName string) ColorScheme {
-	scheme, exists := colorSchemes[schemeName]
+	scheme, exists := lookupColorScheme(schemeName)
 	if !exists {
 		return classicScheme // fallback
 	}
 	return scheme
 }
 
-// GetAvailableColorSchemes returns all registered color scheme names, sorted alphabetically
+// GetAvailableColorSchemes returns all registered color scheme names
+// (built-in and user-defined), sorted alphabetically
 func GetAvailableColorSchemes() []string {
-	schemes := make([]string, 0, len(colorSchemes))
+	schemes := make([]string, 0, len(colorSchemes)+len(customColorSchemes))
 	for name := range colorSchemes {
 		schemes = append(schemes, name)
 	}
+	for name := range customColorSchemes {
+		schemes = append(schemes, name)
+	}
 	// Sort alphabetically for predictable cycling order
 	sort.Strings(schemes)
 	return schemes
@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"time"
@@ -19,23 +20,20 @@ import (
 func (m Model) renderTimeline() string {
 	var b strings.Builder
 
-	// Header (displayNow applies any scrub offset)
-	localTime := m.displayNow()
-	header := fmt.Sprintf("🌍 Timeline View - Local Time: %s (%s)",
-		FormatTime(localTime, m.config.TimeFormat),
-		FormatDate(localTime))
-	if m.timeOffset != 0 {
-		header += fmt.Sprintf("  ⏩ scrubbed %s", formatOffsetString(m.timeOffset.Hours()))
-	}
-	b.WriteString(headerStyle.Render(header))
+	// Header
+	b.WriteString(m.renderTimelineHeader())
 	b.WriteString("\n\n")
 
+	// Your own row, pinned above the scrolling rows
+	b.WriteString(m.renderMeTimelineRow())
+
 	// Calculate visible range
+	rows := m.visibleRows()
 	start := m.scrollOffset
-	end := min(start+MaxVisible, len(m.colleagues))
+	end := min(start+rows, len(m.colleagues))
 
 	// Show scroll indicators
-	topIndicator, bottomIndicator := renderScrollIndicators(m.scrollOffset, MaxVisible, len(m.colleagues))
+	topIndicator, bottomIndicator := renderScrollIndicators(m.scrollOffset, rows, len(m.colleagues))
 	b.WriteString(topIndicator)
 
 	// Render visible colleagues (shifted by any scrub offset)
@@ -46,6 +44,10 @@ func (m Model) renderTimeline() string {
 			b.WriteString(m.renderInvalidTimelineRow(ct))
 		case m.config.TimelineMode == "individual":
 			b.WriteString(m.renderTimelineRow(i, ct))
+			if m.rowLabelsShown() {
+				b.WriteString("\n")
+				b.WriteString(m.renderRowLabels(ct))
+			}
 		default:
 			b.WriteString(m.renderSharedTimelineRow(i, ct))
 		}
@@ -55,6 +57,11 @@ func (m Model) renderTimeline() string {
 	// Show bottom scroll indicator
 	b.WriteString(bottomIndicator)
 
+	if len(m.colleagues) == 0 && m.filterQuery != "" {
+		b.WriteString(footerStyle.Render(fmt.Sprintf("No colleagues match '%s'", m.filterQuery)))
+		b.WriteString("\n")
+	}
+
 	// Team overlap summary (shared mode, two or more valid colleagues)
 	if m.config.TimelineMode == "shared" {
 		if row := m.renderOverlapRow(); row != "" {
@@ -63,34 +70,70 @@ func (m Model) renderTimeline() string {
 		}
 	}
 
-	// Add hour labels once at bottom for both modes
-	barWidth := m.calculateTimelineBarWidth()
-	labels := m.renderHourLabels(barWidth, NameFieldWidth+TimeFieldWidth+2)
-	b.WriteString(labels)
+	// Add hour labels once at bottom for both modes (plus the dates in
+	// a shared window spanning several days)
+	b.WriteString(m.renderTimelineLabels())
 	b.WriteString("\n")
+	if dates := m.renderTimelineDates(); dates != "" {
+		b.WriteString(dates)
+		b.WriteString("\n")
+	}
 
 	// Legend
 	b.WriteString(m.renderTimelineLegend())
+	if m.errorMsg != "" {
+		// E.g. a failed ':' command
+		b.WriteString("\n")
+		b.WriteString(errorStyle.Render("Error: " + m.errorMsg))
+	} else {
+		b.WriteString(m.renderStatus())
+	}
 	b.WriteString("\n")
 
-	// Footer with keybindings
-	b.WriteString(m.renderTimelineFooter())
+	// Footer with keybindings (or the filter prompt / command line while open)
+	switch m.inputMode {
+	case ModeFilter:
+		b.WriteString(m.renderFilterPrompt())
+	case ModeCommand:
+		b.WriteString(m.renderCommandPrompt())
+	case ModeGoto:
+		b.WriteString(m.renderGotoPrompt())
+	case ModeRange:
+		b.WriteString(m.renderRangePopup())
+	default:
+		b.WriteString(m.renderTimelineFooter())
+	}
 
 	return b.String()
 }
 
+// renderTimelineHeader renders the timeline view's title line
+// (displayNow applies any scrub offset)
+func (m Model) renderTimelineHeader() string {
+	localTime := m.displayNow()
+	header := fmt.Sprintf("🌍 Timeline View%s - %s: %s (%s)",
+		m.profileLabel(),
+		m.referenceLabel(),
+		FormatTime(localTime, m.config.TimeFormat),
+		FormatDate(localTime))
+	if m.timeOffset != 0 {
+		header += fmt.Sprintf("  ⏩ scrubbed %s", formatScrubOffset(m.timeOffset))
+	}
+	header += m.filterLabel() + m.markedLabel()
+	return headerStyle.Render(header)
+}
+
 // renderTimelineRow renders a single colleague's timeline row (without labels)
 func (m Model) renderTimelineRow(index int, ct ColleagueTime) string {
-	// Name and location (max NameFieldWidth chars)
-	nameStr := ct.Colleague.Name
-	nameStr = truncateOrPad(nameStr, NameFieldWidth)
+	// Column widths fitted to the terminal
+	nameWidth, timeWidth, barWidth := m.timelineLayout()
+
+	// Name and location
+	nameStr := truncateOrPad(ct.Colleague.Name, nameWidth)
 
 	// Current time
 	timeStr := FormatTime(ct.CurrentTime, m.config.TimeFormat)
-	timeStr = truncateOrPad(timeStr, TimeFieldWidth)
-
-	// Calculate bar width
-	barWidth := m.calculateTimelineBarWidth()
+	timeStr = truncateOrPad(timeStr, timeWidth)
 
 	// Generate the timeline bar
 	bar := m.renderIndividualBar(ct, barWidth)
@@ -131,36 +174,87 @@ func barCharForHour(ct ColleagueTime, hour float64) rune {
 	return '▓' // Awake off-hours
 }
 
-// renderIndividualBar generates a timeline bar for individual mode
+// barTick marks a cell of a timeline bar. Ticks are drawn as the cell's
+// background, so the cell keeps its work/sleep glyph and agrees with
+// the overlap row.
+type barTick int
+
+const (
+	tickNone       barTick = iota
+	tickDay                // The colleague's midnight: their date changes
+	tickTransition         // Their UTC offset changes (DST transition)
+)
+
+// renderIndividualBar generates a timeline bar for individual mode: the
+// colleague's own wall clock over the timeline window
 func (m Model) renderIndividualBar(ct ColleagueTime, barWidth int) string {
-	bar := make([]rune, barWidth)
+	w := m.windowAt(ct.CurrentTime)
+	markerPos := w.exactPosition(ct.CurrentTime, barWidth)
+	bar, weekend, ticks := individualBarCells(ct, w, barWidth)
 
-	// Get current hour position (0-24)
-	currentHour := float64(ct.CurrentTime.Hour())
-	currentMinute := float64(ct.CurrentTime.Minute())
-	currentPosition := (currentHour + currentMinute/60.0) / 24.0 // 0.0 to 1.0
-	markerIndex := int(currentPosition * float64(barWidth))
+	// Apply colors
+	return m.colorizeBar(bar, weekend, ticks, markerPos, nil)
+}
+
+// individualBarCells classifies the cells of an individual bar showing
+// window w on the colleague's own clock
+func individualBarCells(ct ColleagueTime, w timelineWindow, barWidth int) ([]rune, []bool, []barTick) {
+	bar := make([]rune, barWidth)
+	weekend := make([]bool, barWidth)
+	ticks := make([]barTick, barWidth)
 
 	// Build bar character by character (fractional hours keep sub-hour
-	// boundaries accurate at 2 chars per hour)
+	// boundaries accurate at 2 chars per hour). Each cell is classified
+	// on its own day; offset changes and new days are ticked where they
+	// happen. The marker position is colored differently, not replaced.
+	var prev time.Time
 	for i := range barWidth {
-		hour := float64(i) / float64(barWidth) * 24.0
-
-		// Marker position will be colored differently, not replaced
-		bar[i] = barCharForHour(ct, hour)
+		t := w.at(i, barWidth)
+		switch {
+		case i > 0 && utcOffset(t) != utcOffset(prev):
+			ticks[i] = tickTransition
+		case i > 0 && t.Day() != prev.Day():
+			ticks[i] = tickDay
+		}
+		bar[i] = barCharAt(ct.Colleague, t)
+		weekend[i] = !ct.Colleague.IsWorkday(t)
+		prev = t
 	}
+	return bar, weekend, ticks
+}
 
-	// Apply colors
-	return m.colorizeBar(bar, ct, markerIndex)
+// utcOffset returns t's UTC offset in seconds
+func utcOffset(t time.Time) int {
+	_, offset := t.Zone()
+	return offset
 }
 
-// colorizeBar applies color styling to the timeline bar
-func (m Model) colorizeBar(bar []rune, ct ColleagueTime, markerIndex int) string {
+// markerGlyphs fill the marker cell from the left as time moves
+// through it, in eighths
+var markerGlyphs = []rune("▏▎▍▌▋▊▉█")
+
+// markerGlyph returns the marker for a position on the bar (see
+// timelineWindow.exactPosition): the part of its cell already passed,
+// so the marker moves smoothly rather than a cell at a time
+func markerGlyph(pos float64) rune {
+	frac := pos - math.Floor(pos)
+	return markerGlyphs[min(int(frac*float64(len(markerGlyphs))), len(markerGlyphs)-1)]
+}
+
+// colorizeBar applies color styling to the timeline bar, drawing the
+// current time marker at markerPos (-1 for none). Awake cells that fall
+// on the colleague's weekend (per cell, as a window can span several
+// days) get the weekend tint, and ticked cells a background.
+func (m Model) colorizeBar(bar []rune, weekend []bool, ticks []barTick, markerPos float64, selected []bool) string {
 	scheme := getCurrentColorScheme(m.config.ColorScheme)
 	var result strings.Builder
 
 	result.WriteString("[")
 
+	markerIndex := -1
+	if markerPos >= 0 {
+		markerIndex = int(markerPos)
+	}
 	for i, char := range bar {
 		var style lipgloss.Style
 
@@ -169,21 +263,33 @@ func (m Model) colorizeBar(bar []rune, ct ColleagueTime, markerIndex int) string
 			style = lipgloss.NewStyle().
 				Foreground(scheme.MarkerColor).
 				Bold(true)
+			char = markerGlyph(markerPos)
 		} else {
 			switch char {
 			case '░': // Sleep
 				style = lipgloss.NewStyle().Foreground(scheme.SleepColor)
 			case '▓': // Awake off
-				style = lipgloss.NewStyle().Foreground(scheme.AwakeOffColor)
-			case '█': // Work
-				if ct.IsWeekend {
+				if weekend[i] {
 					style = lipgloss.NewStyle().Foreground(scheme.WeekendTint)
 				} else {
-					style = lipgloss.NewStyle().Foreground(scheme.WorkColor)
+					style = lipgloss.NewStyle().Foreground(scheme.AwakeOffColor)
 				}
+			case '█': // Work
+				style = lipgloss.NewStyle().Foreground(scheme.WorkColor)
 			}
 		}
 
+		switch ticks[i] {
+		case tickTransition: // Clock change
+			style = style.Background(scheme.Warning)
+		case tickDay: // New day
+			style = style.Background(scheme.Muted)
+		}
+
+		if selected != nil && selected[i] {
+			// Mouse-selected range
+			style = style.Reverse(true)
+		}
 		result.WriteString(style.Render(string(char)))
 	}
 
@@ -191,54 +297,70 @@ func (m Model) colorizeBar(bar []rune, ct ColleagueTime, markerIndex int) string
 	return result.String()
 }
 
-// renderHourLabels renders the hour labels below the timeline bar
-func (m Model) renderHourLabels(barWidth int, leftPadding int) string {
-	// Build the label line character by character for proper alignment
-	labelChars := make([]rune, barWidth+2) // +2 for brackets
+// renderTimelineLabels renders the hour labels under the timeline bars:
+// the reference zone's clock in shared mode. In individual mode each row
+// shows its colleague's own clock, so a centered window is labelled
+// relative to now.
+func (m Model) renderTimelineLabels() string {
+	nameWidth, timeWidth, barWidth := m.timelineLayout()
+	relative := m.config.TimelineMode != "shared" && m.config.CentersTimeline()
+	return m.renderHourLabels(m.windowAt(m.displayNow()), barWidth, nameWidth+timeWidth+2, relative)
+}
 
-	// Initialize with spaces
-	for i := range labelChars {
-		labelChars[i] = ' '
+// renderHourLabels renders the hour labels below a timeline bar showing
+// window w: wall-clock hours, or hours from the window's center ("-6h",
+// "now", "+6h") if relative
+func (m Model) renderHourLabels(w timelineWindow, barWidth int, leftPadding int, relative bool) string {
+	var labels []hourLabel
+	if relative {
+		labels = relativeLabels(w, barWidth)
+	} else {
+		labels = wallClockLabels(w, barWidth, m.config.TimeFormat)
 	}
+	padding := strings.Repeat(" ", leftPadding)
+	return footerStyle.Render(padding + placeLabels(labels, barWidth))
+}
 
-	// Set brackets
-	labelChars[0] = '['
-	labelChars[barWidth+1] = ']'
-
-	// Calculate positions for each hour (0, 6, 12, 18, 24)
-	// Hour X should be at position: (X / 24.0) * barWidth
-	hours := []int{0, 6, 12, 18, 24}
-
-	for _, hour := range hours {
-		// Calculate exact position for this hour in the bar
-		centerPos := int(float64(hour) / 24.0 * float64(barWidth))
-
-		// Convert hour to string
-		label := fmt.Sprintf("%d", hour)
-		labelLen := len(label)
+// renderTimelineDates renders the dates under a shared-mode window that
+// spans more than one day, with a separator at each midnight; "" when
+// the window shows a single day
+func (m Model) renderTimelineDates() string {
+	if m.config.TimelineMode != "shared" {
+		return ""
+	}
+	w := m.windowAt(m.displayNow())
+	midnights := w.midnights()
+	if len(midnights) == 0 {
+		return ""
+	}
+	nameWidth, timeWidth, barWidth := m.timelineLayout()
 
-		// Calculate start position to center the label
-		// +1 accounts for the opening bracket
-		startPos := max(
-			// Ensure we don't go out of bounds
-			centerPos-(labelLen/2)+1, 1)
-		if startPos+labelLen > barWidth+1 {
-			startPos = barWidth + 1 - labelLen
+	line := []rune(strings.Repeat(" ", barWidth+2))
+	put := func(pos int, text string) {
+		r := []rune(text)
+		if pos < 1 || pos+len(r) > barWidth+1 {
+			return
 		}
-
-		// Place each character of the label
-		for i, ch := range label {
-			pos := startPos + i
-			if pos > 0 && pos <= barWidth {
-				labelChars[pos] = ch
+		for i := range r {
+			if line[pos+i] != ' ' {
+				return
 			}
 		}
+		copy(line[pos:], r)
 	}
 
-	// Add left padding
-	padding := strings.Repeat(" ", leftPadding)
+	// Midnights first, so they win over the start date when space is short
+	s := w.start
+	for _, h := range midnights {
+		pos := 1 + h*barWidth/w.hours
+		day := time.Date(s.Year(), s.Month(), s.Day(), s.Hour()+h, 0, 0, 0, s.Location())
+		line[pos] = '│'
+		put(pos+1, day.Format("Mon 2"))
+	}
+	put(1, s.Format("Mon 2"))
 
-	return footerStyle.Render(padding + string(labelChars))
+	// offHoursStyle: muted like the footer but without its top margin
+	return offHoursStyle.Render(strings.Repeat(" ", nameWidth+timeWidth+2) + string(line))
 }
 
 // renderTimelineLegend renders the legend explaining timeline symbols
@@ -248,11 +370,15 @@ func (m Model) renderTimelineLegend() string {
 	sleep := lipgloss.NewStyle().Foreground(scheme.SleepColor).Render("░")
 	awake := lipgloss.NewStyle().Foreground(scheme.AwakeOffColor).Render("▓")
 	work := lipgloss.NewStyle().Foreground(scheme.WorkColor).Render("█")
-	// Show the marker as a highlighted block to indicate color highlighting
-	marker := lipgloss.NewStyle().Foreground(scheme.MarkerColor).Bold(true).Render("█")
+	// The marker fills its cell as time passes through it
+	marker := lipgloss.NewStyle().Foreground(scheme.MarkerColor).Bold(true).Render("▏▌█")
+
+	weekend := lipgloss.NewStyle().Foreground(scheme.WeekendTint).Render("▓")
+	change := lipgloss.NewStyle().Foreground(scheme.SleepColor).Background(scheme.Warning).Render("░")
+	day := lipgloss.NewStyle().Foreground(scheme.SleepColor).Background(scheme.Muted).Render("░")
 
-	legend := fmt.Sprintf("\n%s sleep • %s off-hours • %s work • %s now",
-		sleep, awake, work, marker)
+	legend := fmt.Sprintf("\n%s sleep • %s off-hours • %s weekend • %s work • %s now • %s their midnight • %s clock change",
+		sleep, awake, weekend, work, marker, day, change)
 
 	// Overlap row legend (shared mode only)
 	if m.config.TimelineMode == "shared" {
@@ -271,33 +397,70 @@ func (m Model) renderTimelineFooter() string {
 		mode = "shared"
 	}
 
+	k := m.keys
+	scroll := ""
+	if k.Up.Enabled() && k.Down.Enabled() {
+		scroll = primaryKeyLabel(k.Up) + "/" + primaryKeyLabel(k.Down) + " scroll"
+	}
+	scrub := ""
+	if k.ScrubBack.Enabled() && k.ScrubForward.Enabled() {
+		scrub = primaryKeyLabel(k.ScrubBack) + "/" + primaryKeyLabel(k.ScrubForward) + " scrub time"
+	}
+	days := ""
+	if k.PrevDay.Enabled() && k.NextDay.Enabled() {
+		days = primaryKeyLabel(k.PrevDay) + "/" + primaryKeyLabel(k.NextDay) + " day"
+	}
+
+	rowLabels := ""
+	if m.config.TimelineMode != "shared" {
+		rowLabels = footerItem(k.RowLabels, "row labels: "+m.rowLabelsLabel())
+	}
+
 	help := []string{
-		"t normal mode",
-		"m " + mode,
-		"↑/↓ scroll",
-		"←/→ scrub time",
-		"c cycle colors",
-		"? help",
-		"q quit",
+		footerItem(k.Timeline, "normal mode"),
+		footerItem(k.Mode, mode),
+		footerItem(k.Window, "window: "+m.windowLabel()),
+		rowLabels,
+		scroll,
+		scrub,
+		days,
+		footerItem(k.Goto, ""),
+		footerItem(k.Week, ""),
+		footerItem(k.Colors, ""),
+		footerItem(k.Anchor, ""),
+		footerItem(k.Sort, "sort: "+m.config.SortBy),
+		footerItem(k.Filter, ""),
+		footerItem(k.Command, ""),
+		footerItem(k.Help, ""),
+		footerItem(k.Quit, ""),
 	}
 	if m.timeOffset != 0 {
-		help = append(help, "esc back to now")
+		help = append(help, footerItem(k.Back, "back to now"))
 	}
-	return footerStyle.Render(strings.Join(help, " • "))
+	return footerStyle.Render(joinFooter(help...))
 }
 
 // calculateTimelineBarWidth calculates the appropriate bar width based on terminal size
 func (m Model) calculateTimelineBarWidth() int {
 	// Reserve space for name, time, padding, and brackets
 	reservedSpace := NameFieldWidth + TimeFieldWidth + 5 + 2
-	available := m.width - reservedSpace
+	return m.barWidthFor(m.width - reservedSpace)
+}
 
+// barWidthFor returns the bar width for available columns: at least
+// MinBarWidth, and past IdealBarWidth only in whole cells per hour
+func (m Model) barWidthFor(available int) int {
 	// Ensure minimum
 	if available < MinBarWidth {
 		return MinBarWidth
 	}
 
-	// Cap at ideal width
+	// Past the ideal width, grow in whole cells per hour so quarter and
+	// half hours land on cell boundaries
+	hours := m.config.TimelineWindowHours()
+	if perHour := min(available/hours, MaxCellsPerHour); perHour*hours > IdealBarWidth {
+		return perHour * hours
+	}
 	if available > IdealBarWidth {
 		return IdealBarWidth
 	}
@@ -324,9 +487,11 @@ func truncateOrPad(s string, width int) string {
 	return runewidth.FillRight(runewidth.Truncate(s, width, ""), width)
 }
 
-// calculateOffsetHours calculates the hour offset between two times
+// calculateOffsetHours calculates the hour offset between t's zone and
+// localTz at the instant t (not now, so a scrubbed time across a DST
+// change gets the offset in effect then)
 func calculateOffsetHours(t time.Time, localTz *time.Location) float64 {
-	localTime := time.Now().In(localTz)
+	localTime := t.In(localTz)
 	_, localOffset := localTime.Zone()
 	_, remoteOffset := t.Zone()
 
@@ -337,29 +502,22 @@ func calculateOffsetHours(t time.Time, localTz *time.Location) float64 {
 // renderInvalidTimelineRow renders a warning row for a colleague whose
 // timezone failed to load (no time or bar can be computed)
 func (m Model) renderInvalidTimelineRow(ct ColleagueTime) string {
-	nameStr := truncateOrPad(ct.Colleague.Name, NameFieldWidth)
+	nameWidth, _, _ := m.timelineLayout()
+	nameStr := truncateOrPad(ct.Colleague.Name, nameWidth)
 	msg := fmt.Sprintf("⚠ invalid timezone %q — edit or delete", ct.Colleague.Timezone)
 	return fmt.Sprintf("%s %s", invalidStyle.Render(nameStr), invalidStyle.Render(msg))
 }
 
 // renderSharedTimelineRow renders a single colleague's row in shared timeline mode
 func (m Model) renderSharedTimelineRow(index int, ct ColleagueTime) string {
-	// Calculate offset hours
-	offsetHours := calculateOffsetHours(ct.CurrentTime, m.localTimezone)
-
-	// Name (same format as individual mode)
-	nameStr := ct.Colleague.Name
-	nameStr = truncateOrPad(nameStr, NameFieldWidth)
-
-	// Current time (same format as individual mode)
+	// Name and time columns (same layout as individual mode)
+	nameWidth, timeWidth, barWidth := m.timelineLayout()
+	nameStr := truncateOrPad(ct.Colleague.Name, nameWidth)
 	timeStr := FormatTime(ct.CurrentTime, m.config.TimeFormat)
-	timeStr = truncateOrPad(timeStr, TimeFieldWidth)
-
-	// Calculate bar width
-	barWidth := m.calculateTimelineBarWidth()
+	timeStr = truncateOrPad(timeStr, timeWidth)
 
 	// Generate shifted timeline bar
-	bar := m.renderSharedBar(ct, offsetHours, barWidth)
+	bar := m.renderSharedBar(ct, barWidth)
 
 	// Apply name styling based on current status
 	nameStyle := getNameStyle(ct)
@@ -367,61 +525,82 @@ func (m Model) renderSharedTimelineRow(index int, ct ColleagueTime) string {
 	return fmt.Sprintf("%s %s %s", nameStyle.Render(nameStr), timeStr, bar)
 }
 
-// renderSharedBar generates a timeline bar for shared mode (shifted by offset)
-func (m Model) renderSharedBar(ct ColleagueTime, offsetHours float64, barWidth int) string {
-	bar := make([]rune, barWidth)
-
-	// Calculate current time marker position (local time, scrub-aware)
+// renderSharedBar generates a timeline bar for shared mode: the
+// reference zone's window, with each position classified at the
+// colleague's local time for that instant
+func (m Model) renderSharedBar(ct ColleagueTime, barWidth int) string {
+	// Current time marker position (local time, scrub-aware)
 	localTime := m.displayNow()
-	currentHour := float64(localTime.Hour())
-	currentMinute := float64(localTime.Minute())
-	currentPosition := (currentHour + currentMinute/60.0) / 24.0
-	markerIndex := int(currentPosition * float64(barWidth))
-
-	// Build bar with shift applied
-	for i := range barWidth {
-		bar[i] = barCharForHour(ct, sharedBarHour(i, barWidth, offsetHours))
-	}
+	w := m.windowAt(localTime)
+	markerPos := w.exactPosition(localTime, barWidth)
+	bar, weekend, ticks := sharedBarCells(ct, w, barWidth)
 
-	// Pass markerIndex to colorizer for proper styling (will highlight that position)
-	return m.colorizeBar(bar, ct, markerIndex)
+	// The colorizer draws the marker over that position
+	return m.colorizeBar(bar, weekend, ticks, markerPos, m.selectedCells(w, barWidth))
 }
 
-// sharedBarHour converts a shared-mode bar position (which represents
-// local time) into the colleague's local hour by applying their offset,
-// wrapping at day boundaries. If they're +3h ahead, when local is
-// 12:00 they're at 15:00. Fractional so half- and quarter-hour offsets
-// (India +5:30, Nepal +5:45) stay exact.
-func sharedBarHour(position, barWidth int, offsetHours float64) float64 {
-	hour := float64(position)/float64(barWidth)*24.0 + offsetHours
-
-	// Wrap around if needed
-	for hour < 0 {
-		hour += 24.0
-	}
-	for hour >= 24.0 {
-		hour -= 24.0
+// sharedBarCells classifies the cells of a shared bar showing the
+// reference zone's window w, each at the colleague's local time
+func sharedBarCells(ct ColleagueTime, w timelineWindow, barWidth int) ([]rune, []bool, []barTick) {
+	bar := make([]rune, barWidth)
+	weekend := make([]bool, barWidth)
+	ticks := make([]barTick, barWidth)
+
+	// Each cell is classified on the colleague's own day at that
+	// instant, so their weekend can begin mid-bar. The cell where an
+	// offset change takes effect is ticked, and their midnight too.
+	loc := ct.CurrentTime.Location()
+	offsets := sharedBarOffsets(loc, w, barWidth)
+	var prev time.Time
+	for i, offsetHours := range offsets {
+		local := w.at(i, barWidth).In(loc)
+		switch {
+		case i > 0 && offsetHours != offsets[i-1]:
+			ticks[i] = tickTransition
+		case i > 0 && local.Day() != prev.Day():
+			ticks[i] = tickDay
+		}
+		bar[i] = barCharAt(ct.Colleague, local)
+		weekend[i] = !ct.Colleague.IsWorkday(local)
+		prev = local
 	}
+	return bar, weekend, ticks
+}
 
-	return hour
+// sharedBarOffsets returns, for each position of a shared-mode window
+// (in the reference zone), the hour offset between loc and the
+// reference zone at that position's instant. The offset is constant
+// except across a change of either zone's UTC offset.
+func sharedBarOffsets(loc *time.Location, w timelineWindow, barWidth int) []float64 {
+	offsets := make([]float64, barWidth)
+	for i := range offsets {
+		offsets[i] = calculateOffsetHours(w.at(i, barWidth).In(loc), w.start.Location())
+	}
+	return offsets
 }
 
-// computeSharedOverlap returns, for each shared-bar position, how many
-// of the given colleagues are working at that moment of the local day,
-// plus the number of colleagues counted. Invalid-timezone entries are
-// ignored.
-func computeSharedOverlap(colleagues []ColleagueTime, localTz *time.Location, barWidth int) ([]int, int) {
+// computeSharedOverlap returns, for each position of a shared-mode
+// window, how many of the given colleagues are working at that moment,
+// plus the number of colleagues counted. Each position is classified at
+// the colleague's local time for its instant, so DST changes and
+// weekends starting mid-window count correctly. Invalid-timezone
+// entries are ignored.
+func computeSharedOverlap(colleagues []ColleagueTime, w timelineWindow, barWidth int) ([]int, int) {
 	counts := make([]int, barWidth)
 	total := 0
 
+	instants := make([]time.Time, barWidth)
+	for i := range instants {
+		instants[i] = w.at(i, barWidth)
+	}
 	for _, ct := range colleagues {
 		if ct.InvalidTimezone {
 			continue
 		}
 		total++
-		offsetHours := calculateOffsetHours(ct.CurrentTime, localTz)
-		for i := range counts {
-			if barCharForHour(ct, sharedBarHour(i, barWidth, offsetHours)) == '█' {
+		loc := ct.CurrentTime.Location()
+		for i, t := range instants {
+			if barCharAt(ct.Colleague, t.In(loc)) == '█' {
 				counts[i]++
 			}
 		}
@@ -432,26 +611,41 @@ func computeSharedOverlap(colleagues []ColleagueTime, localTz *time.Location, ba
 
 // renderOverlapRow renders the team-overlap summary row for shared
 // mode: where everyone is working, where a majority is, and how many
-// are working right now. Returns "" when fewer than two colleagues
-// have valid timezones.
+// are working right now. With colleagues marked, only they count.
+// Returns "" when fewer than two colleagues have valid timezones.
 func (m Model) renderOverlapRow() string {
-	barWidth := m.calculateTimelineBarWidth()
-
-	// Count against scrubbed times so the row follows time scrubbing
-	// (the weekday, and with it the work blocks, can change)
-	cts := make([]ColleagueTime, len(m.colleagues))
-	for i, ct := range m.colleagues {
-		cts[i] = m.scrubbed(ct)
+	nameWidth, timeWidth, barWidth := m.timelineLayout()
+	label, bar, working, total := m.renderOverlapBar(barWidth)
+	if bar == "" {
+		return ""
 	}
-	counts, total := computeSharedOverlap(cts, m.localTimezone, barWidth)
+
+	nameStr := truncateOrPad(label, nameWidth)
+	nowStr := truncateOrPad(fmt.Sprintf("%d/%d now", working, total), timeWidth)
+
+	// offHoursStyle: muted like the footer but without its top margin
+	return fmt.Sprintf("%s %s %s", offHoursStyle.Render(nameStr), nowStr, bar)
+}
+
+// renderOverlapBar renders the team-overlap bar of barWidth, with its
+// label and how many of the counted colleagues are working now; bar is
+// "" when fewer than two colleagues have valid timezones
+func (m Model) renderOverlapBar(barWidth int) (label, bar string, working, total int) {
+	label, counted := m.overlapColleagues()
+
+	// Cells are classified at their own instant, so the row follows
+	// time scrubbing through the window
+	localTime := m.displayNow()
+	w := m.windowAt(localTime)
+	counts, total := computeSharedOverlap(counted, w, barWidth)
 	if total < 2 {
-		return ""
+		return label, "", 0, total
 	}
 
 	// Current time marker at the local-time position, like every shared row
-	localTime := m.displayNow()
-	currentPosition := (float64(localTime.Hour()) + float64(localTime.Minute())/60.0) / 24.0
-	markerIndex := int(currentPosition * float64(barWidth))
+	markerPos := w.exactPosition(localTime, barWidth)
+	markerIndex := w.position(localTime, barWidth)
+	selected := m.selectedCells(w, barWidth)
 
 	scheme := getCurrentColorScheme(m.config.ColorScheme)
 	allStyle := lipgloss.NewStyle().Foreground(scheme.Success)
@@ -459,8 +653,8 @@ func (m Model) renderOverlapRow() string {
 	noneStyle := lipgloss.NewStyle().Foreground(scheme.Muted)
 	markerStyle := lipgloss.NewStyle().Foreground(scheme.MarkerColor).Bold(true)
 
-	var bar strings.Builder
-	bar.WriteString("[")
+	var b strings.Builder
+	b.WriteString("[")
 	for i, count := range counts {
 		var char string
 		var style lipgloss.Style
@@ -473,17 +667,19 @@ func (m Model) renderOverlapRow() string {
 			char, style = "░", noneStyle
 		}
 		if i == markerIndex {
-			style = markerStyle
+			char, style = string(markerGlyph(markerPos)), markerStyle
+		}
+		if selected != nil && selected[i] {
+			style = style.Reverse(true)
 		}
-		bar.WriteString(style.Render(char))
+		b.WriteString(style.Render(char))
 	}
-	bar.WriteString("]")
+	b.WriteString("]")
 
-	nameStr := truncateOrPad("Team overlap", NameFieldWidth)
-	nowStr := truncateOrPad(fmt.Sprintf("%d/%d now", counts[markerIndex], total), TimeFieldWidth)
-
-	// offHoursStyle: muted like the footer but without its top margin
-	return fmt.Sprintf("%s %s %s", offHoursStyle.Render(nameStr), nowStr, bar.String())
+	if markerIndex >= 0 {
+		working = counts[markerIndex]
+	}
+	return label, b.String(), working, total
 }
 
 // formatOffsetString formats the offset hours as a string
@@ -1,7 +1,8 @@
 package main
 
 import (
-	"math"
+	"reflect"
+	"slices"
 	"testing"
 	"time"
 
@@ -94,9 +95,10 @@ func TestCalculateTimelineBarWidth(t *testing.T) {
 	}{
 		{"very narrow terminal", 40, MinBarWidth},
 		{"narrow terminal", 60, MinBarWidth},
-		{"medium terminal", 80, 36},                // 80 - 44 = 36 (between min and ideal)
-		{"wide terminal", 100, IdealBarWidth},      // 100 - 44 = 56, capped at IdealBarWidth (48)
-		{"very wide terminal", 200, IdealBarWidth}, // capped at IdealBarWidth
+		{"medium terminal", 80, 36},           // 80 - 44 = 36 (between min and ideal)
+		{"wide terminal", 100, IdealBarWidth}, // 100 - 44 = 56, not enough for 3 per hour
+		{"wider terminal", 120, 72},           // 120 - 44 = 76: 3 cells per hour
+		{"very wide terminal", 200, 96},       // capped at MaxCellsPerHour
 	}
 
 	for _, tt := range tests {
@@ -113,8 +115,8 @@ func TestCalculateTimelineBarWidth(t *testing.T) {
 			if result < MinBarWidth {
 				t.Errorf("result %d is less than MinBarWidth %d", result, MinBarWidth)
 			}
-			if result > IdealBarWidth {
-				t.Errorf("result %d exceeds IdealBarWidth %d", result, IdealBarWidth)
+			if result > MaxCellsPerHour*24 {
+				t.Errorf("result %d exceeds %d cells per hour", result, MaxCellsPerHour)
 			}
 		})
 	}
@@ -300,10 +302,9 @@ func TestRenderSharedBarOffsetDirection(t *testing.T) {
 		IsWeekend:     false,
 	}
 
-	offsetHours := 3.0
 	barWidth := 48
 
-	result := m.renderSharedBar(ct, offsetHours, barWidth)
+	result := m.renderSharedBar(ct, barWidth)
 
 	// Verify result is not empty
 	if len(result) == 0 {
@@ -382,7 +383,7 @@ func TestScrubbed(t *testing.T) {
 	}
 
 	// +2h crosses midnight into Saturday
-	m := Model{timeOffset: 2 * time.Hour}
+	m := Model{timeOffset: 2 * time.Hour, localTimezone: time.UTC}
 	s := m.scrubbed(ct)
 	if !s.IsWeekend {
 		t.Error("Expected scrub across midnight Friday->Saturday to set IsWeekend")
@@ -392,7 +393,7 @@ func TestScrubbed(t *testing.T) {
 	}
 
 	// Scrub from Friday 23:00 back into working hours (default 9-17)
-	m = Model{timeOffset: -8 * time.Hour}
+	m = Model{timeOffset: -8 * time.Hour, localTimezone: time.UTC}
 	s = m.scrubbed(ct)
 	if !s.IsWorkingTime {
 		t.Error("Expected 15:00 Friday to be working time after -8h scrub")
@@ -400,7 +401,7 @@ func TestScrubbed(t *testing.T) {
 
 	// Zero offset returns the value unchanged
 	m = Model{}
-	if got := m.scrubbed(ct); got != ct {
+	if got := m.scrubbed(ct); !reflect.DeepEqual(got, ct) {
 		t.Error("Expected zero scrub to return the input unchanged")
 	}
 }
@@ -431,7 +432,8 @@ func TestComputeSharedOverlap(t *testing.T) {
 		},
 	}
 
-	counts, total := computeSharedOverlap(colleagues, time.UTC, barWidth)
+	day := timelineWindow{start: time.Date(2025, 1, 20, 0, 0, 0, 0, time.UTC), hours: 24}
+	counts, total := computeSharedOverlap(colleagues, day, barWidth)
 
 	if total != 2 {
 		t.Fatalf("total = %d, want 2 (invalid entry ignored)", total)
@@ -454,36 +456,96 @@ func TestComputeSharedOverlap(t *testing.T) {
 	}
 }
 
-// TestSharedBarHour tests position-to-their-hour conversion including
-// wraparound and sub-hour offsets
-func TestSharedBarHour(t *testing.T) {
+// TestSharedOverlapSubHourOffsets tests that half- and quarter-hour
+// zones land exactly at 2 chars per hour
+func TestSharedOverlapSubHourOffsets(t *testing.T) {
+	day := timelineWindow{start: time.Date(2025, 1, 20, 0, 0, 0, 0, time.UTC), hours: 24} // Monday
+	const barWidth = 48
+
 	tests := []struct {
-		name        string
-		position    int
-		barWidth    int
-		offsetHours float64
-		expected    float64
+		name   string
+		offset int // Seconds east of UTC
+		first  int // First working position (9:00 their time)
+		last   int // Last working position (before 17:00 their time); below first when it wraps past midnight
 	}{
-		{"no offset", 12, 24, 0, 12},
-		{"positive offset", 12, 24, 3, 15},
-		{"wraps past midnight", 22, 24, 5, 3},
-		{"negative offset", 2, 24, -5, 21},
-		{"half-hour offset kept exact", 12, 24, 5.5, 17.5},
-		{"quarter-hour offset kept exact", 0, 24, 5.75, 5.75},
-		{"half position at 2 chars per hour", 19, 48, 0, 9.5},
+		{"UTC", 0, 18, 33},
+		{"India +5:30", 5*3600 + 1800, 7, 22},
+		{"Nepal +5:45 (9:00-17:00 is 03:15-11:15 UTC)", 5*3600 + 2700, 7, 22},
+		{"New York -5", -5 * 3600, 28, 43},
+		{"Auckland +12 (9:00-17:00 is 21:00-05:00 UTC, wrapping)", 12 * 3600, 42, 9},
 	}
-
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := sharedBarHour(tt.position, tt.barWidth, tt.offsetHours)
-			if math.Abs(got-tt.expected) > 1e-9 {
-				t.Errorf("sharedBarHour(%d, %d, %v) = %v, want %v",
-					tt.position, tt.barWidth, tt.offsetHours, got, tt.expected)
+			zone := time.FixedZone(tt.name, tt.offset)
+			ct := ColleagueTime{Colleague: Colleague{Name: "A"}, CurrentTime: day.start.In(zone)}
+			counts, _ := computeSharedOverlap([]ColleagueTime{ct}, day, barWidth)
+			for i, count := range counts {
+				working := i >= tt.first && i <= tt.last
+				if tt.last < tt.first {
+					working = i >= tt.first || i <= tt.last
+				}
+				want := 0
+				if working {
+					want = 1
+				}
+				if count != want {
+					t.Errorf("counts[%d] = %d, want %d", i, count, want)
+				}
 			}
 		})
 	}
 }
 
+// TestSharedOverlapQuarterHours tests that at 4 cells per hour, half
+// and quarter-hour offsets start and end work exactly on a cell
+func TestSharedOverlapQuarterHours(t *testing.T) {
+	day := timelineWindow{start: time.Date(2025, 1, 20, 0, 0, 0, 0, time.UTC), hours: 24} // Monday
+	const barWidth = 96
+
+	tests := []struct {
+		name        string
+		offset      int
+		first, last int
+	}{
+		{"India +5:30 (03:30-11:30 UTC)", 5*3600 + 1800, 14, 45},
+		{"Nepal +5:45 (03:15-11:15 UTC)", 5*3600 + 2700, 13, 44},
+	}
+	for _, tt := range tests {
+		zone := time.FixedZone(tt.name, tt.offset)
+		ct := ColleagueTime{Colleague: Colleague{Name: "A"}, CurrentTime: day.start.In(zone)}
+		counts, _ := computeSharedOverlap([]ColleagueTime{ct}, day, barWidth)
+		first, last := -1, -1
+		for i, count := range counts {
+			if count == 1 {
+				last = i
+				if first < 0 {
+					first = i
+				}
+			}
+		}
+		if first != tt.first || last != tt.last {
+			t.Errorf("%s: working cells %d-%d, want %d-%d", tt.name, first, last, tt.first, tt.last)
+		}
+	}
+}
+
+func TestMarkerGlyph(t *testing.T) {
+	tests := []struct {
+		pos  float64
+		want rune
+	}{
+		{10, '▏'},
+		{10.5, '▋'}, // Into the fifth eighth
+		{10.99, '█'},
+		{0.25, '▍'},
+	}
+	for _, tt := range tests {
+		if got := markerGlyph(tt.pos); got != tt.want {
+			t.Errorf("markerGlyph(%v) = %q, want %q", tt.pos, got, tt.want)
+		}
+	}
+}
+
 // TestColleagueGetters tests the accessor methods with defaults
 func TestColleagueGetters(t *testing.T) {
 	t.Run("default values when unset", func(t *testing.T) {
@@ -546,3 +608,145 @@ func TestColleagueGetters(t *testing.T) {
 		}
 	})
 }
+
+// TestSharedBarOffsetsAcrossDST tests that shared-bar offsets follow a
+// transition within the window: London's 2024-10-27 fall-back changes
+// its offset from UTC+1 to UTC+0 at 01:00 UTC
+func TestSharedBarOffsetsAcrossDST(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Skip("tzdata unavailable")
+	}
+	day := timelineWindow{start: time.Date(2024, 10, 27, 0, 0, 0, 0, time.UTC), hours: 24}
+	const barWidth = 24 // Position == UTC hour
+
+	offsets := sharedBarOffsets(london, day, barWidth)
+	if offsets[0] != 1 || offsets[1] != 0 || offsets[23] != 0 {
+		t.Errorf("offsets[0,1,23] = %v, %v, %v, want 1, 0, 0", offsets[0], offsets[1], offsets[23])
+	}
+
+	// The transition is ticked where it takes effect
+	ct := ColleagueTime{Colleague: Colleague{Name: "L", Timezone: "Europe/London"}, CurrentTime: day.start.In(london)}
+	_, _, ticks := sharedBarCells(ct, day, barWidth)
+	if got := slices.Index(ticks, tickTransition); got != 1 {
+		t.Errorf("transition tick at %d, want position 1", got)
+	}
+}
+
+// TestScrubbedAcrossDST tests that scrubbing into another offset period
+// recomputes the offset and zone abbreviation for the scrubbed instant
+func TestScrubbedAcrossDST(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("tzdata unavailable")
+	}
+	summer := time.Date(2024, 7, 1, 12, 0, 0, 0, newYork)
+	ct := ColleagueTime{
+		Colleague:   Colleague{Name: "NY", Timezone: "America/New_York"},
+		CurrentTime: summer,
+		Offset:      "-4h",
+	}
+
+	m := Model{timeOffset: 184 * 24 * time.Hour, localTimezone: time.UTC} // Jan 1
+	s := m.scrubbed(ct)
+	if s.Offset != "-5h" {
+		t.Errorf("Offset = %q, want -5h in winter", s.Offset)
+	}
+	if abbr, _ := s.CurrentTime.Zone(); abbr != "EST" {
+		t.Errorf("zone = %q, want EST", abbr)
+	}
+	if s.CurrentTime.Hour() != 11 {
+		t.Errorf("hour = %d, want 11 (the same instant an hour earlier on the wall clock)", s.CurrentTime.Hour())
+	}
+}
+
+// TestIndividualBarTransition tests the transition tick on an
+// individual bar (the colleague's own day)
+func TestIndividualBarTransition(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("tzdata unavailable")
+	}
+	m := Model{config: Config{ColorScheme: "classic"}}
+	const barWidth = 48
+
+	// 2024-03-10: clocks jump from 02:00 to 03:00; the tick is on the
+	// first cell of the new offset, which keeps its sleep glyph
+	changeDay := ColleagueTime{CurrentTime: time.Date(2024, 3, 10, 12, 0, 0, 0, newYork)}
+	bar, _, ticks := individualBarCells(changeDay, m.windowAt(changeDay.CurrentTime), barWidth)
+	if got := slices.Index(ticks, tickTransition); got != 6 {
+		t.Errorf("transition tick at %d, want position 6 (03:00)", got)
+	}
+	if bar[6] != '░' {
+		t.Errorf("bar = %q, want sleep at the transition", string(bar))
+	}
+	plainDay := ColleagueTime{CurrentTime: time.Date(2024, 3, 11, 12, 0, 0, 0, newYork)}
+	if _, _, ticks = individualBarCells(plainDay, m.windowAt(plainDay.CurrentTime), barWidth); slices.Contains(ticks, tickTransition) {
+		t.Error("transition tick on a day without one")
+	}
+}
+
+// TestSharedBarWeekendPerCell tests that a colleague whose Saturday
+// begins mid-bar shows no work after their midnight, with a tick there
+func TestSharedBarWeekendPerCell(t *testing.T) {
+	newYork := time.FixedZone("EST", -5*3600)
+	tokyo := time.FixedZone("JST", 9*3600)
+	friday := time.Date(2025, 1, 24, 12, 0, 0, 0, newYork)
+
+	m := Model{config: Config{ColorScheme: "classic", TimelineMode: "shared"}, localTimezone: newYork}
+	m.timeOffset = friday.Sub(time.Now())
+	ct := ColleagueTime{Colleague: Colleague{Name: "T", Timezone: "Asia/Tokyo"}, CurrentTime: friday.In(tokyo)}
+
+	const barWidth = 24 // Position == New York hour
+	bar := []rune(stripANSI(m.renderSharedBar(ct, barWidth)))[1 : barWidth+1]
+
+	// Tokyo's midnight is 10:00 in New York
+	_, _, ticks := sharedBarCells(ct, m.windowAt(friday), barWidth)
+	if got := slices.Index(ticks, tickDay); got != 10 {
+		t.Errorf("midnight tick at %d, want 10", got)
+	}
+	// Their Friday 17:00-19:00 (NY 3-5) is off; their Saturday 9-17
+	// (NY 19-03) would be work hours but is the weekend
+	for i := 19; i < barWidth; i++ {
+		if bar[i] == '█' {
+			t.Errorf("bar = %q, want no work on their Saturday (position %d)", string(bar), i)
+			break
+		}
+	}
+	// Their Friday 09:00-17:00 is the previous NY evening; NY 0-3 is
+	// their Friday 14-17
+	if bar[1] != '█' {
+		t.Errorf("bar = %q, want their Friday afternoon as work at 1", string(bar))
+	}
+}
+
+// TestSharedBarAgreesWithOverlapAtTicks tests that a ticked cell keeps
+// its classification, so the bar and the overlap row agree there: a
+// Tokyo shift starting at their midnight shows as work from the tick on
+func TestSharedBarAgreesWithOverlapAtTicks(t *testing.T) {
+	newYork := time.FixedZone("EST", -5*3600)
+	tokyo := time.FixedZone("JST", 9*3600)
+	thursday := time.Date(2025, 1, 23, 12, 0, 0, 0, newYork)
+	day := timelineWindow{start: time.Date(2025, 1, 23, 0, 0, 0, 0, newYork), hours: 24}
+	const barWidth = 24 // Position == New York hour
+
+	ct := ColleagueTime{
+		Colleague:   Colleague{Name: "T", Timezone: "Asia/Tokyo", WorkStart: HourPtr(0), WorkEnd: HourPtr(8)},
+		CurrentTime: thursday.In(tokyo),
+	}
+	bar, _, ticks := sharedBarCells(ct, day, barWidth)
+	counts, _ := computeSharedOverlap([]ColleagueTime{ct}, day, barWidth)
+
+	// Their Friday 00:00 is 10:00 in New York
+	if ticks[10] != tickDay {
+		t.Fatalf("ticks = %v, want their midnight at 10", ticks)
+	}
+	for i := range barWidth {
+		if working := bar[i] == '█'; working != (counts[i] == 1) {
+			t.Errorf("position %d: bar %q, overlap count %d", i, bar[i], counts[i])
+		}
+	}
+	if bar[10] != '█' {
+		t.Errorf("bar = %q, want work at the midnight tick", string(bar))
+	}
+}
@@ -11,7 +11,6 @@ import (
 // them in the UI
 func ComputeColleagueTimes(colleagues []Colleague, localTz *time.Location) []ColleagueTime {
 	now := time.Now()
-	localNow := now.In(localTz)
 
 	result := make([]ColleagueTime, 0, len(colleagues))
 
@@ -26,42 +25,73 @@ func ComputeColleagueTimes(colleagues []Colleague, localTz *time.Location) []Col
 			continue
 		}
 
-		colleagueTime := now.In(loc)
-
-		// Calculate offset in fractional hours so half-hour zones
-		// (e.g. India +5:30) display correctly
-		_, localOffset := localNow.Zone()
-		_, colleagueOffset := colleagueTime.Zone()
-		offsetHours := float64(colleagueOffset-localOffset) / 3600.0
-		offsetStr := formatOffsetString(offsetHours)
-
-		// Check if it's weekend
-		isWeekend := colleagueTime.Weekday() == time.Saturday || colleagueTime.Weekday() == time.Sunday
-
-		// Check if it's working time (accessors supply defaults for unset
-		// hours; isInTimeRange handles overnight ranges like 16-0)
-		hour := colleagueTime.Hour()
-		isWorkingTime := !isWeekend && isInTimeRange(hour, colleague.GetWorkStart(), colleague.GetWorkEnd())
-
-		// Surface upcoming DST transitions so offset changes don't surprise
-		dstAt, dstDelta, hasDST := nextOffsetChange(loc, now, DSTLookahead)
-
-		result = append(result, ColleagueTime{
-			Colleague:     colleague,
-			ConfigIndex:   i,
-			CurrentTime:   colleagueTime,
-			Offset:        offsetStr,
-			IsWorkingTime: isWorkingTime,
-			IsWeekend:     isWeekend,
-			DSTChangeAt:   dstAt,
-			DSTDeltaHours: dstDelta,
-			HasDSTChange:  hasDST,
-		})
+		ct := colleagueTimeIn(colleague, loc, localTz, now)
+		ct.ConfigIndex = i
+		result = append(result, ct)
 	}
 
 	return result
 }
 
+// colleagueTimeIn calculates the time and metadata of colleague c,
+// whose clock is in loc, at now; offsets are measured from localTz
+func colleagueTimeIn(c Colleague, loc, localTz *time.Location, now time.Time) ColleagueTime {
+	colleagueTime := now.In(loc)
+
+	// Calculate offset in fractional hours so half-hour zones
+	// (e.g. India +5:30) display correctly
+	_, localOffset := now.In(localTz).Zone()
+	_, colleagueOffset := colleagueTime.Zone()
+	offsetHours := float64(colleagueOffset-localOffset) / 3600.0
+	offsetStr := formatOffsetString(offsetHours)
+
+	// Check if it's one of their days off
+	isWeekend := !c.IsWorkday(colleagueTime)
+
+	// Check if it's working time (accessors supply defaults for unset
+	// hours; isInTimeRange handles overnight ranges like 16-0)
+	hour := colleagueTime.Hour()
+	isWorkingTime := !isWeekend && isInTimeRange(hour, c.GetWorkStart(), c.GetWorkEnd())
+
+	// Surface upcoming DST transitions so offset changes don't surprise
+	dstAt, dstDelta, hasDST := nextOffsetChange(loc, now, DSTLookahead)
+
+	return ColleagueTime{
+		Colleague:      c,
+		CurrentTime:    colleagueTime,
+		Offset:         offsetStr,
+		IsWorkingTime:  isWorkingTime,
+		IsWeekend:      isWeekend,
+		NextWorkChange: nextWorkChange(c, colleagueTime),
+		DSTChangeAt:    dstAt,
+		DSTDeltaHours:  dstDelta,
+		HasDSTChange:   hasDST,
+	}
+}
+
+// WorkChangeSearch bounds the search for the next work start or end: a
+// full week covers any weekday/weekend pattern
+const WorkChangeSearch = 8 * 24
+
+// nextWorkChange finds the next hour boundary at which the colleague's
+// working state flips, with the same rule as IsWorkingTime: workdays
+// only, overnight ranges via isInTimeRange. Returns the zero time if it
+// never flips (empty work hours).
+func nextWorkChange(c Colleague, now time.Time) time.Time {
+	working := func(t time.Time) bool {
+		return c.IsWorkday(t) && isInTimeRange(t.Hour(), c.GetWorkStart(), c.GetWorkEnd())
+	}
+	current := working(now)
+	t := atHour(now, now.Hour())
+	for range WorkChangeSearch {
+		t = t.Add(time.Hour) // Absolute hours, so DST gaps are skipped correctly
+		if working(t) != current {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
 // DSTLookahead is how far ahead colleagues' upcoming UTC-offset
 // changes (DST transitions) are surfaced in the list view
 const DSTLookahead = 7 * 24 * time.Hour
@@ -94,6 +124,28 @@ func nextOffsetChange(loc *time.Location, from time.Time, lookahead time.Duratio
 	return hi.In(loc), float64(afterOff-startOff) / 3600.0, true
 }
 
+// offsetChange is one UTC-offset transition of a zone
+type offsetChange struct {
+	At         time.Time // In the zone
+	DeltaHours float64
+}
+
+// upcomingOffsetChanges lists up to limit offset transitions of loc
+// within horizon of from, searched a DSTLookahead window at a time
+func upcomingOffsetChanges(loc *time.Location, from time.Time, horizon time.Duration, limit int) []offsetChange {
+	var changes []offsetChange
+	for t := from; t.Before(from.Add(horizon)) && len(changes) < limit; {
+		at, delta, ok := nextOffsetChange(loc, t, DSTLookahead)
+		if !ok {
+			t = t.Add(DSTLookahead)
+			continue
+		}
+		changes = append(changes, offsetChange{At: at, DeltaHours: delta})
+		t = at.Add(time.Minute)
+	}
+	return changes
+}
+
 // FormatTime formats a time according to the specified format
 func FormatTime(t time.Time, format string) string {
 	if format == "12h" {
@@ -102,6 +154,14 @@ func FormatTime(t time.Time, format string) string {
 	return t.Format("15:04:05")
 }
 
+// FormatTimeShort formats a time to the minute, e.g. for labels
+func FormatTimeShort(t time.Time, format string) string {
+	if format == "12h" {
+		return t.Format("3:04 PM")
+	}
+	return t.Format("15:04")
+}
+
 // FormatDate formats the date and day of week
 func FormatDate(t time.Time) string {
 	return t.Format("Mon, Jan 02")
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"strings"
 	"testing"
 	"time"
 )
@@ -280,3 +281,77 @@ func TestWorkingHoursDetection(t *testing.T) {
 		})
 	}
 }
+
+func TestLocalTimezoneFromConfigAndOverride(t *testing.T) {
+	config := DefaultConfig()
+	config.LocalTimezone = "Asia/Tokyo"
+	config.Colleagues = []Colleague{{Name: "Charlie (Tokyo)", Timezone: "Asia/Tokyo"}}
+	m := NewModel(config, t.TempDir()+"/config.yaml")
+
+	if m.localTimezone.String() != "Asia/Tokyo" {
+		t.Fatalf("localTimezone = %v, want Asia/Tokyo from local_timezone", m.localTimezone)
+	}
+	if m.colleagues[0].Offset != "same" {
+		t.Errorf("Offset = %q, want same when local_timezone matches", m.colleagues[0].Offset)
+	}
+
+	// -tz wins over the config and recomputes offsets
+	if err := m.setTimezoneOverride("Asia/Kolkata"); err != nil {
+		t.Fatalf("setTimezoneOverride failed: %v", err)
+	}
+	if m.colleagues[0].Offset != "+3.5h" {
+		t.Errorf("Offset = %q, want +3.5h from Kolkata", m.colleagues[0].Offset)
+	}
+	if err := m.setTimezoneOverride("Not/AZone"); err == nil {
+		t.Error("Expected error for invalid -tz value")
+	}
+
+	if _, err := parseConfig([]byte("local_timezone: Mars/Olympus\n")); err == nil {
+		t.Error("Expected parseConfig to reject an invalid local_timezone")
+	}
+}
+
+func TestCycleAnchor(t *testing.T) {
+	config := DefaultConfig()
+	config.LocalTimezone = "UTC"
+	config.Colleagues = []Colleague{
+		{Name: "Ravi (Kolkata)", Timezone: "Asia/Kolkata"},
+		{Name: "Broken", Timezone: "Bad/Zone"},
+		{Name: "Kofi (Accra)", Timezone: "Africa/Accra"},
+	}
+	m := NewModel(config, t.TempDir()+"/config.yaml")
+
+	// No selection: steps through valid colleagues, skipping invalid ones
+	m.cycleAnchor()
+	if m.referenceTimezone().String() != "Asia/Kolkata" {
+		t.Fatalf("reference = %v, want Asia/Kolkata", m.referenceTimezone())
+	}
+	if m.colleagues[0].Offset != "same" || m.colleagues[2].Offset != "-5.5h" {
+		t.Errorf("Offsets = %q/%q, want same/-5.5h anchored to Kolkata",
+			m.colleagues[0].Offset, m.colleagues[2].Offset)
+	}
+	if !strings.Contains(m.View(), "⚓ Ravi (Kolkata)") {
+		t.Error("Header should name the anchored colleague")
+	}
+
+	m.cycleAnchor()
+	if m.referenceTimezone().String() != "Africa/Accra" {
+		t.Errorf("reference = %v, want Africa/Accra", m.referenceTimezone())
+	}
+	m.cycleAnchor()
+	if m.anchorTz != nil || m.referenceTimezone().String() != "UTC" {
+		t.Errorf("Expected anchor released after the last colleague, got %v", m.referenceTimezone())
+	}
+
+	// With a selection, the key toggles that colleague's anchor
+	m.cursor = 2
+	m.selectionActive = true
+	m.cycleAnchor()
+	if m.anchorName != "Kofi (Accra)" {
+		t.Errorf("anchorName = %q, want selected colleague", m.anchorName)
+	}
+	m.cycleAnchor()
+	if m.anchorTz != nil {
+		t.Error("Expected second press on the anchored colleague to release")
+	}
+}
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// DefaultTrashRetentionDays is how long deleted colleagues stay
+// restorable when the config sets no trash_retention_days
+const DefaultTrashRetentionDays = 30
+
+// TrashedColleague is a deleted colleague kept in the config's trash
+// section until it is restored or expires
+type TrashedColleague struct {
+	Colleague `yaml:",inline"`
+	DeletedAt time.Time `yaml:"deleted_at"`
+	Profile   string    `yaml:"profile,omitempty"` // Roster it was deleted from; "" = default
+}
+
+// ConfirmsDelete reports whether delete asks for confirmation (the
+// default) or acts immediately
+func (c Config) ConfirmsDelete() bool {
+	return c.ConfirmDelete == nil || *c.ConfirmDelete
+}
+
+// TrashRetention returns how long trashed colleagues are kept
+func (c Config) TrashRetention() time.Duration {
+	days := c.TrashRetentionDays
+	if days == 0 {
+		days = DefaultTrashRetentionDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// purgeTrash drops trash entries older than the retention period
+func (m *Model) purgeTrash(now time.Time) {
+	cutoff := now.Add(-m.config.TrashRetention())
+	kept := m.config.Trash[:0:0]
+	for _, t := range m.config.Trash {
+		if t.DeletedAt.After(cutoff) {
+			kept = append(kept, t)
+		}
+	}
+	m.config.Trash = kept
+}
+
+// trashColleagues moves config colleagues into the trash (newest
+// first) and removes them from the roster. The caller records history
+// and saves.
+func (m *Model) trashColleagues(indices []int) {
+	profile := m.profile
+	if profile == DefaultProfile {
+		profile = ""
+	}
+	now := time.Now()
+
+	drop := make(map[int]bool, len(indices))
+	var trashed []TrashedColleague
+	for _, index := range indices {
+		if index >= 0 && index < len(m.config.Colleagues) && !drop[index] {
+			drop[index] = true
+			trashed = append(trashed, TrashedColleague{Colleague: m.config.Colleagues[index], DeletedAt: now, Profile: profile})
+		}
+	}
+	kept := m.config.Colleagues[:0:0]
+	for i, c := range m.config.Colleagues {
+		if !drop[i] {
+			kept = append(kept, c)
+		}
+	}
+	m.config.Colleagues = kept
+	m.config.Trash = append(trashed, m.config.Trash...)
+	m.clearMarks() // Later indices shifted
+}
+
+// restoreFromTrash moves a trash entry back to the end of the active
+// profile's roster and saves. An entry deleted from another profile
+// that still exists is left for that profile to restore, so it goes
+// back to the roster it came from.
+func (m *Model) restoreFromTrash(i int) error {
+	if i < 0 || i >= len(m.config.Trash) {
+		return nil
+	}
+	t := m.config.Trash[i]
+	origin := t.Profile
+	if origin == "" {
+		origin = DefaultProfile
+	}
+	if origin != m.profile && slices.Contains(m.fullConfig.ProfileNames(), origin) {
+		m.setStatus(fmt.Sprintf("%s is from profile %s: switch to it to restore", t.Name, origin))
+		return nil
+	}
+	m.recordHistory("restore " + t.Name)
+	m.config.Trash = append(m.config.Trash[:i:i], m.config.Trash[i+1:]...)
+	m.config.Colleagues = append(m.config.Colleagues, t.Colleague)
+	m.updateColleagueTimes()
+	m.setStatus("restored " + t.Name)
+	return m.saveConfig()
+}
+
+// purgeFromTrash permanently removes a trash entry and saves
+func (m *Model) purgeFromTrash(i int) error {
+	if i < 0 || i >= len(m.config.Trash) {
+		return nil
+	}
+	m.recordHistory("purge " + m.config.Trash[i].Name)
+	m.config.Trash = append(m.config.Trash[:i:i], m.config.Trash[i+1:]...)
+	return m.saveConfig()
+}
+
+// openTrash shows the trash screen
+func (m *Model) openTrash() {
+	m.purgeTrash(time.Now())
+	m.inputMode = ModeTrash
+	m.trashCursor = 0
+	m.errorMsg = ""
+}
+
+// requestDelete deletes the given config colleagues, first asking for
+// confirmation unless confirm_delete is off
+func (m *Model) requestDelete(indices []int) error {
+	if len(indices) == 0 {
+		return nil
+	}
+	if m.config.ConfirmsDelete() {
+		m.inputMode = ModeConfirmDelete
+		m.editTargets = indices
+		m.errorMsg = ""
+		return nil
+	}
+	return m.confirmDelete(indices)
+}
+
+// confirmDelete performs a (confirmed) delete and drops the selection
+func (m *Model) confirmDelete(indices []int) error {
+	if err := m.deleteColleagues(indices); err != nil {
+		return err
+	}
+	m.cursor = -1
+	m.selectionActive = false
+	return nil
+}
+
+// renderConfirmDelete renders the delete confirmation prompt
+func (m Model) renderConfirmDelete() string {
+	var b strings.Builder
+	b.WriteString(promptStyle.Render(fmt.Sprintf("Delete %s? ", m.quotedTargets(m.editTargets))))
+	b.WriteString("\n")
+	b.WriteString(footerStyle.Render(fmt.Sprintf("y delete (restorable from the trash for %d days) • n/Esc cancel",
+		int(m.config.TrashRetention().Hours()/24))))
+	return b.String()
+}
+
+// quotedTargets names a delete's targets: 'Alice', or "3 colleagues"
+func (m Model) quotedTargets(indices []int) string {
+	if len(indices) == 1 {
+		return fmt.Sprintf("'%s'", m.colleagueName(indices[0]))
+	}
+	return m.targetsName(indices)
+}
+
+// renderTrash renders the trash screen
+func (m Model) renderTrash() string {
+	var b strings.Builder
+
+	b.WriteString(headerStyle.Render("🗑  Trash"))
+	b.WriteString("\n")
+
+	if len(m.config.Trash) == 0 {
+		b.WriteString(footerStyle.Render("Trash is empty. Deleted colleagues show up here."))
+		b.WriteString("\n")
+	}
+	now := time.Now()
+	for i, t := range m.config.Trash {
+		cursor := "  "
+		style := rowStyle
+		if i == m.trashCursor {
+			cursor = "▶ "
+			style = selectedRowStyle
+		}
+		from := ""
+		if t.Profile != "" {
+			from = ", from " + t.Profile
+		}
+		line := fmt.Sprintf("%s%s  %s  %s", cursor, t.Name,
+			dateStyle.Render(t.Timezone),
+			dateStyle.Render(fmt.Sprintf("(deleted %s ago%s)", formatCountdown(now.Sub(t.DeletedAt)), from)))
+		b.WriteString(style.Render(line))
+		b.WriteString("\n")
+	}
+
+	if m.errorMsg != "" {
+		b.WriteString(errorStyle.Render("Error: " + m.errorMsg))
+		b.WriteString("\n")
+	}
+	if m.statusMsg != "" {
+		b.WriteString(dateStyle.Render(m.statusMsg))
+		b.WriteString("\n")
+	}
+	b.WriteString(footerStyle.Render(fmt.Sprintf("↑/↓ select • Enter restore to %s • x delete forever • Esc back", m.profile)))
+	return b.String()
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestDeleteConfirmation(t *testing.T) {
+	m, path := newReloadTestModel(t) // Alice (New York), Bob (London), Charlie (Tokyo)
+	m.cursor = 1
+	m.activateSelection()
+
+	next, _ := m.handleNormalMode(keyMsg("d"))
+	m = next.(Model)
+	if m.inputMode != ModeConfirmDelete {
+		t.Fatalf("Expected confirmation prompt, got mode %v", m.inputMode)
+	}
+	if got := stripANSI(m.renderConfirmDelete()); !strings.Contains(got, "Delete 'Bob (London)'?") {
+		t.Errorf("confirm prompt = %q", got)
+	}
+	next, _ = m.handleConfirmDeleteMode(tea.KeyMsg{Type: tea.KeyEsc})
+	m = next.(Model)
+	if m.inputMode != ModeNormal || len(m.config.Colleagues) != 3 {
+		t.Fatalf("Esc should cancel: mode %v, %d colleagues", m.inputMode, len(m.config.Colleagues))
+	}
+
+	// confirm_delete: false deletes straight away
+	m.config.ConfirmDelete = new(bool)
+	m.activateSelection()
+	next, _ = m.handleNormalMode(keyMsg("d"))
+	m = next.(Model)
+	loaded, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig failed: %v", err)
+	}
+	if len(loaded.Colleagues) != 2 || len(loaded.Trash) != 1 || loaded.Trash[0].Name != "Bob (London)" {
+		t.Fatalf("Expected Bob in the saved trash, got colleagues %+v trash %+v", loaded.Colleagues, loaded.Trash)
+	}
+	if since := time.Since(loaded.Trash[0].DeletedAt); since < 0 || since > time.Minute {
+		t.Errorf("deleted_at = %v, want about now", loaded.Trash[0].DeletedAt)
+	}
+}
+
+func TestTrashRestoreAndPurge(t *testing.T) {
+	m, path := newReloadTestModel(t)
+	if err := m.deleteColleague(0); err != nil {
+		t.Fatalf("deleteColleague failed: %v", err)
+	}
+
+	next, _ := m.handleNormalMode(keyMsg("D"))
+	m = next.(Model)
+	if m.inputMode != ModeTrash || !strings.Contains(stripANSI(m.renderTrash()), "Alice (New York)") {
+		t.Fatalf("Expected trash screen listing Alice, got mode %v", m.inputMode)
+	}
+	next, _ = m.handleTrashMode(tea.KeyMsg{Type: tea.KeyEnter})
+	m = next.(Model)
+	if len(m.config.Trash) != 0 || len(m.config.Colleagues) != 3 || m.config.Colleagues[2].Name != "Alice (New York)" {
+		t.Fatalf("Restore should append Alice: colleagues %+v trash %+v", m.config.Colleagues, m.config.Trash)
+	}
+
+	// Entries past the retention period are dropped on the next save
+	m.config.TrashRetentionDays = 7
+	m.config.Trash = []TrashedColleague{
+		{Colleague: newColleague("Old", "UTC"), DeletedAt: time.Now().Add(-8 * 24 * time.Hour)},
+		{Colleague: newColleague("Recent", "UTC"), DeletedAt: time.Now().Add(-6 * 24 * time.Hour)},
+	}
+	if err := m.saveConfig(); err != nil {
+		t.Fatalf("saveConfig failed: %v", err)
+	}
+	loaded, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig failed: %v", err)
+	}
+	if len(loaded.Trash) != 1 || loaded.Trash[0].Name != "Recent" {
+		t.Errorf("Expected only Recent kept, got %+v", loaded.Trash)
+	}
+}
+
+func TestTrashRestoreToOriginProfile(t *testing.T) {
+	m, path := newProfilesTestModel(t) // Default: Alice; family: Mum, Kid
+	if err := m.switchProfile("family"); err != nil {
+		t.Fatalf("switchProfile failed: %v", err)
+	}
+	if err := m.deleteColleague(0); err != nil {
+		t.Fatalf("deleteColleague failed: %v", err)
+	}
+	if err := m.switchProfile(DefaultProfile); err != nil {
+		t.Fatalf("switchProfile failed: %v", err)
+	}
+
+	// From the default profile Mum stays in the trash
+	if err := m.restoreFromTrash(0); err != nil {
+		t.Fatalf("restoreFromTrash failed: %v", err)
+	}
+	if len(m.config.Colleagues) != 1 || len(m.config.Trash) != 1 || !strings.Contains(m.statusMsg, "profile family") {
+		t.Fatalf("restored into the default profile: colleagues %+v, status %q", m.config.Colleagues, m.statusMsg)
+	}
+
+	if err := m.switchProfile("family"); err != nil {
+		t.Fatalf("switchProfile failed: %v", err)
+	}
+	if err := m.restoreFromTrash(0); err != nil {
+		t.Fatalf("restoreFromTrash failed: %v", err)
+	}
+	loaded, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig failed: %v", err)
+	}
+	family := loaded.Profiles["family"].Colleagues
+	if len(loaded.Colleagues) != 1 || len(family) != 2 || family[1].Name != "Mum" || len(loaded.Trash) != 0 {
+		t.Errorf("saved default %+v, family %+v, trash %+v; want Mum back in family", loaded.Colleagues, family, loaded.Trash)
+	}
+}
+
+func TestTrashConfigValidation(t *testing.T) {
+	if _, err := parseConfig([]byte("trash_retention_days: -1\n")); err == nil {
+		t.Error("Expected an error for negative trash_retention_days")
+	}
+	config, err := parseConfig([]byte("colleagues: []\n"))
+	if err != nil {
+		t.Fatalf("parseConfig failed: %v", err)
+	}
+	if !config.ConfirmsDelete() || config.TrashRetention() != DefaultTrashRetentionDays*24*time.Hour {
+		t.Errorf("defaults: confirm %v retention %v", config.ConfirmsDelete(), config.TrashRetention())
+	}
+}
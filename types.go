@@ -1,6 +1,7 @@
 package main
 
 import (
+	"strings"
 	"time"
 
 	"github.com/charmbracelet/bubbles/textinput"
@@ -10,12 +11,15 @@ import (
 // Hour fields are pointers so that 0 (midnight) is a valid configured
 // value; nil means "use the default".
 type Colleague struct {
-	Name       string `yaml:"name"`
-	Timezone   string `yaml:"timezone"`
-	WorkStart  *int   `yaml:"work_start,omitempty"`  // Hour in 24h format (e.g., 9 for 9am)
-	WorkEnd    *int   `yaml:"work_end,omitempty"`    // Hour in 24h format (e.g., 17 for 5pm)
-	SleepStart *int   `yaml:"sleep_start,omitempty"` // Hour in 24h format (e.g., 23 for 11pm)
-	SleepEnd   *int   `yaml:"sleep_end,omitempty"`   // Hour in 24h format (e.g., 7 for 7am)
+	Name       string   `yaml:"name"`
+	Timezone   string   `yaml:"timezone"`
+	WorkStart  *int     `yaml:"work_start,omitempty"`  // Hour in 24h format (e.g., 9 for 9am)
+	WorkEnd    *int     `yaml:"work_end,omitempty"`    // Hour in 24h format (e.g., 17 for 5pm)
+	SleepStart *int     `yaml:"sleep_start,omitempty"` // Hour in 24h format (e.g., 23 for 11pm)
+	SleepEnd   *int     `yaml:"sleep_end,omitempty"`   // Hour in 24h format (e.g., 7 for 7am)
+	Tags       []string `yaml:"tags,omitempty"`        // Free-form labels, shown by the tags column
+
+	workdays []string // Your own row's workdays (see Me); empty = Monday to Friday
 }
 
 // HourPtr returns a pointer to an hour value, for setting Colleague hour fields
@@ -55,13 +59,53 @@ func (c Colleague) GetSleepEnd() int {
 	return *c.SleepEnd
 }
 
+// IsWorkday reports whether t falls on one of the colleague's workdays:
+// Monday to Friday, except for your own row, which follows the me:
+// section
+func (c Colleague) IsWorkday(t time.Time) bool {
+	if len(c.workdays) == 0 {
+		return !isWeekendDay(t)
+	}
+	for _, day := range c.workdays {
+		if parseWeekday(strings.ToLower(day)) == t.Weekday() {
+			return true
+		}
+	}
+	return false
+}
+
 // Config represents the application configuration
 type Config struct {
-	TimeFormat            string      `yaml:"time_format"`             // "12h" or "24h"
-	LocationDisplayFormat string      `yaml:"location_display_format"` // "auto", "city", "timezone", "abbreviation"
-	ColorScheme           string      `yaml:"color_scheme"`            // "classic", "dark", "high-contrast", "nord", "solarized"
-	TimelineMode          string      `yaml:"timeline_mode"`           // "individual", "shared"
+	TimeFormat            string      `yaml:"time_format"`                   // "12h" or "24h"
+	LocalTimezone         string      `yaml:"local_timezone,omitempty"`      // IANA zone offsets are measured from; "" = system zone
+	LocationDisplayFormat string      `yaml:"location_display_format"`       // "auto", "city", "timezone", "abbreviation"
+	ColorScheme           string      `yaml:"color_scheme"`                  // "classic", "dark", "high-contrast", "nord", "solarized"
+	TimelineMode          string      `yaml:"timeline_mode"`                 // "individual", "shared"
+	TimelineHours         int         `yaml:"timeline_hours,omitempty"`      // Hours the timeline bars span; 0 = DefaultTimelineHours
+	TimelineCentered      bool        `yaml:"timeline_centered,omitempty"`   // Center the window on now instead of starting at midnight
+	TimelineRowLabels     string      `yaml:"timeline_row_labels,omitempty"` // Individual mode: "" = labels once, "own" or "local" per row
+	SplitView             bool        `yaml:"split_view,omitempty"`          // Show the timeline bars beside the list when the terminal is wide enough
+	SortBy                string      `yaml:"sort_by"`                       // "config", "offset", "name", "status"
+	Columns               []string    `yaml:"columns,omitempty"`             // List view columns in order; empty = DefaultColumns
 	Colleagues            []Colleague `yaml:"colleagues"`
+
+	// Delete confirmation (default on), the trash deleted colleagues go
+	// to and your own row; shared by all profiles
+	ConfirmDelete      *bool              `yaml:"confirm_delete,omitempty"`
+	Mouse              *bool              `yaml:"mouse,omitempty"`                // Capture the mouse (default on)
+	TrashRetentionDays int                `yaml:"trash_retention_days,omitempty"` // 0 = DefaultTrashRetentionDays
+	Trash              []TrashedColleague `yaml:"trash,omitempty"`
+	Me                 *Me                `yaml:"me,omitempty"`
+
+	// User-defined color schemes, keyed by name; cycled alongside the built-ins
+	ColorSchemes map[string]CustomColorScheme `yaml:"color_schemes,omitempty"`
+
+	// Key binding overrides, keyed by action name (see KeyMap)
+	Keys map[string]KeyList `yaml:"keys,omitempty"`
+
+	// Additional named rosters; the top-level fields above form the
+	// "default" profile
+	Profiles map[string]Profile `yaml:"profiles,omitempty"`
 }
 
 // ColleagueTime holds computed time information for display
@@ -71,9 +115,13 @@ type ColleagueTime struct {
 	CurrentTime     time.Time
 	Offset          string // e.g., "+5h", "-8h", "same"
 	IsWorkingTime   bool
-	IsWeekend       bool
+	IsWeekend       bool // Not one of their workdays
 	InvalidTimezone bool // Timezone failed to load; time fields are zero
 
+	// When working time next ends (if IsWorkingTime) or starts; zero if
+	// the work hours are empty (start == end)
+	NextWorkChange time.Time
+
 	// Upcoming DST transition within DSTLookahead, if any
 	DSTChangeAt   time.Time // In the colleague's timezone
 	DSTDeltaHours float64   // e.g., +1 (spring forward), -1 (fall back)
@@ -92,7 +140,16 @@ const (
 	ModeEditWorkHours      // Editing selected colleague's work hours
 	ModeEditSleepHours     // Editing selected colleague's sleep hours
 	ModeHelp
-	ModeTimeline // Timeline visualization mode
+	ModeTimeline      // Timeline visualization mode
+	ModeProfiles      // Profile switcher
+	ModeFilter        // '/' filter prompt over the list or timeline
+	ModeEditTags      // Editing the tags of the selected or marked colleagues
+	ModeConfirmDelete // Delete confirmation prompt
+	ModeTrash         // Trash screen (restore deleted colleagues)
+	ModeCommand       // ':' command line over the list or timeline
+	ModeGoto          // Go-to-time prompt over the timeline
+	ModeWeek          // Week heatmap of team overlap (from the timeline)
+	ModeRange         // Popup for a range dragged across the shared timeline
 )
 
 // Application constants
@@ -102,31 +159,42 @@ const (
 	DefaultWorkEnd    = 17              // Default work end hour (5pm)
 	DefaultSleepStart = 23              // Default sleep start hour (11pm)
 	DefaultSleepEnd   = 7               // Default sleep end hour (7am)
-	MaxVisible        = 8               // Maximum colleagues visible at once
+	MaxVisible        = 8               // Colleague rows shown until the terminal size is known
+	MaxSearchVisible  = 10              // Search results shown until the terminal size is known
 
 	// Timeline visualization constants
-	MinBarWidth    = 24 // Minimum bar width (1 char per hour)
-	IdealBarWidth  = 48 // Ideal and maximum bar width (2 chars per hour)
-	NameFieldWidth = 25 // Width for colleague name field
-	TimeFieldWidth = 12 // Width for time field
+	MinBarWidth     = 24 // Minimum bar width (1 char per hour)
+	IdealBarWidth   = 48 // Ideal bar width (2 chars per hour of a day)
+	MaxCellsPerHour = 4  // Wide terminals grow bars up to this, in whole cells per hour
+	NameFieldWidth  = 25 // Width for colleague name field
+	TimeFieldWidth  = 12 // Width for time field
 )
 
 // Model represents the Bubbletea application state
 type Model struct {
-	config          Config
+	config          Config // Active profile's view of the config (see Config.forProfile)
+	fullConfig      Config // Whole config file as last loaded/saved
+	profile         string // Active profile name
 	configPath      string
+	configSource    string    // Which discovery rule chose configPath (shown in help)
 	configMtime     time.Time // Config file mtime at last load/save (for hot-reload)
 	configSize      int64     // Config file size at last load/save (catches same-mtime rewrites)
 	colleagues      []ColleagueTime
-	localTimezone   *time.Location
-	cursor          int           // Selected item index (or last known position)
-	selectionActive bool          // Whether selection is visually shown
-	lastActionTime  time.Time     // Time of last user action (for auto-hide)
-	scrollOffset    int           // Scroll position
-	inputMode       InputMode     // Current input mode
-	timeOffset      time.Duration // Timeline scrub offset from now (0 = live)
+	me              *ColleagueTime // Your own row (config me:), pinned above the list; nil without one
+	localTimezone   *time.Location // Home zone: -tz flag, else local_timezone, else the system zone
+	tzOverride      string         // -tz flag value ("" = none); survives config reloads
+	anchorTz        *time.Location // Temporary reference zone (a colleague's), nil = home zone
+	anchorName      string         // Name of the colleague anchorTz came from
+	keys            KeyMap         // Active key bindings (defaults + config overrides)
+	cursor          int            // Selected item index (or last known position)
+	selectionActive bool           // Whether selection is visually shown
+	lastActionTime  time.Time      // Time of last user action (for auto-hide)
+	scrollOffset    int            // Scroll position
+	inputMode       InputMode      // Current input mode
+	timeOffset      time.Duration  // Timeline scrub offset from now (0 = live)
 	nameInput       textinput.Model
 	editIndex       int    // Index of colleague being edited
+	editTargets     []int  // Config indices a bulk hours/tags edit applies to
 	errorMsg        string // Error message to display
 
 	// Staged hour edits from the 'w' flow; nothing is applied or saved
@@ -142,4 +210,39 @@ type Model struct {
 	searchResults      []SearchResult // Filtered search results
 	searchCursor       int            // Selected result index
 	searchScrollOffset int            // Scroll position in search results
+
+	// Profile switcher state
+	profileCursor int       // Selected entry in the switcher
+	returnMode    InputMode // Mode to go back to when the switcher closes
+
+	// Live filter ('/'); m.colleagues holds only the matching rows
+	filterQuery string
+
+	showDetail bool // Detail pane for the cursor row is open
+
+	marked map[int]bool // Config indices marked for bulk actions (space)
+
+	trashCursor int // Selected entry on the trash screen
+
+	// Week heatmap cursor: day (0 = Monday) and hour in the reference zone
+	weekDay  int
+	weekHour int
+
+	// Mouse drag across a timeline bar, in cells, and the range it
+	// selected on the shared timeline (shown by ModeRange)
+	dragging         bool
+	dragRow          int // Row the drag started on, -1 for none
+	dragFrom, dragTo int
+	rangeFrom        time.Time
+	rangeTo          time.Time
+
+	// ':' command line: session history and Tab completion candidates
+	commandHistory    []string
+	commandHistoryPos int
+	commandHints      []string
+
+	// Undo/redo of in-app edits
+	history   history
+	statusMsg string    // Transient message (e.g. "undid: delete Bob")
+	statusAt  time.Time // When statusMsg was set
 }
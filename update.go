@@ -2,8 +2,10 @@ package main
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
@@ -13,9 +15,14 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.KeyMsg:
 		return m.handleKeyPress(msg)
 
+	case tea.MouseMsg:
+		return m.handleMouse(msg)
+
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
+		// Fewer rows may fit now: keep the selection on screen
+		m.scrollToCursor()
 		return m, nil
 
 	case TickMsg:
@@ -27,6 +34,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.selectionActive = false
 		}
 
+		if m.statusMsg != "" && time.Since(m.statusAt) > StatusTimeout {
+			m.statusMsg = ""
+		}
+
 		return m, tick()
 
 	default:
@@ -56,10 +67,28 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m.handleEditWorkHoursMode(msg)
 	case ModeEditSleepHours:
 		return m.handleEditSleepHoursMode(msg)
+	case ModeEditTags:
+		return m.handleEditTagsMode(msg)
+	case ModeConfirmDelete:
+		return m.handleConfirmDeleteMode(msg)
+	case ModeTrash:
+		return m.handleTrashMode(msg)
 	case ModeHelp:
 		return m.handleHelpMode(msg)
 	case ModeTimeline:
 		return m.handleTimelineMode(msg)
+	case ModeProfiles:
+		return m.handleProfilesMode(msg)
+	case ModeFilter:
+		return m.handleFilterMode(msg)
+	case ModeCommand:
+		return m.handleCommandMode(msg)
+	case ModeGoto:
+		return m.handleGotoMode(msg)
+	case ModeWeek:
+		return m.handleWeekMode(msg)
+	case ModeRange:
+		return m.handleRangeMode(msg)
 	default:
 		return m, nil
 	}
@@ -67,11 +96,26 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 // handleNormalMode handles keys in normal browsing mode
 func (m Model) handleNormalMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	switch msg.String() {
-	case "q", "esc":
+	switch {
+	case m.splitActive() && m.handleScrubKey(msg):
+		// The split view's bars scrub as the timeline's do
+
+	case key.Matches(msg, m.keys.Back) && m.timeOffset != 0:
+		// Esc resets an active scrub, then clears marks, then an active
+		// filter, then quits
+		m.timeOffset = 0
+
+	case key.Matches(msg, m.keys.Back) && len(m.marked) > 0:
+		m.clearMarks()
+
+	case key.Matches(msg, m.keys.Back) && m.filterQuery != "":
+		// First esc clears an active filter; a second quits
+		m.setFilter("")
+
+	case key.Matches(msg, m.keys.Quit, m.keys.Back):
 		return m, tea.Quit
 
-	case "up", "k":
+	case key.Matches(msg, m.keys.Up):
 		// If selection is hidden (inactive), reactivate it first without moving
 		if m.reactivateSelection() {
 			return m, nil
@@ -90,7 +134,7 @@ func (m Model) handleNormalMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			}
 		}
 
-	case "down", "j":
+	case key.Matches(msg, m.keys.Down):
 		// If selection is hidden (inactive), reactivate it first without moving
 		if m.reactivateSelection() {
 			return m, nil
@@ -104,38 +148,83 @@ func (m Model) handleNormalMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.cursor++
 			m.lastActionTime = time.Now()
 			// Adjust scroll if cursor goes below visible area
-			if m.cursor >= m.scrollOffset+MaxVisible {
-				m.scrollOffset = m.cursor - MaxVisible + 1
+			m.scrollToCursor()
+		}
+
+	case key.Matches(msg, m.keys.MoveUp, m.keys.MoveDown):
+		// If selection is hidden (inactive), reactivate it first without moving
+		if m.reactivateSelection() {
+			return m, nil
+		}
+
+		if m.cursor >= 0 && m.selectionActive {
+			delta := 1
+			if key.Matches(msg, m.keys.MoveUp) {
+				delta = -1
+			}
+			if err := m.moveColleague(m.cursor, delta); err != nil {
+				m.errorMsg = err.Error()
+			} else {
+				m.errorMsg = ""
+				m.lastActionTime = time.Now()
+				// Keep the moved row visible
+				m.scrollToCursor()
 			}
 		}
 
-	case "a":
+	case key.Matches(msg, m.keys.Mark):
+		// If selection is hidden (inactive), reactivate it first without marking
+		if m.reactivateSelection() {
+			return m, nil
+		}
+
+		// Mark the row and step down, so consecutive rows mark quickly
+		if m.cursor == -1 && len(m.colleagues) > 0 {
+			m.cursor = 0
+		}
+		m.toggleMark(m.cursor)
+		if m.cursor < len(m.colleagues)-1 {
+			m.cursor++
+		}
+		m.activateSelection()
+		m.scrollToCursor()
+
+	case key.Matches(msg, m.keys.Details):
+		// Toggle the detail pane; with nothing selected, open it on the
+		// first colleague
+		if m.cursor == -1 && len(m.colleagues) > 0 {
+			m.cursor = 0
+			m.showDetail = true
+		} else {
+			m.showDetail = !m.showDetail
+		}
+		m.activateSelection()
+		m.scrollToCursor() // The pane takes rows from the list
+
+	case key.Matches(msg, m.keys.Add):
 		// Add new colleague
 		m.inputMode = ModeAddName
 		m.nameInput = newNameInput()
 		m.nameInput.Focus()
 		m.errorMsg = ""
 
-	case "d":
+	case key.Matches(msg, m.keys.Delete):
 		// If selection is hidden (inactive), reactivate it first without deleting
 		if m.reactivateSelection() {
 			return m, nil
 		}
 
-		// Delete selected colleague (only if something is selected and active).
-		// Use the config index, not the display cursor: the display list skips
-		// entries with invalid timezones, so the two can diverge.
-		if m.cursor >= 0 && m.cursor < len(m.colleagues) && m.selectionActive {
-			if err := m.deleteColleague(m.colleagues[m.cursor].ConfigIndex); err != nil {
-				m.errorMsg = err.Error()
-			} else {
-				// Return to no selection after delete
-				m.cursor = -1
-				m.selectionActive = false
-			}
+		// Delete the marked colleagues, else the selected one (only if
+		// something is selected and active). Use config indices, not the
+		// display cursor: sorting and filtering reorder the display list.
+		if err := m.requestDelete(m.actionTargets()); err != nil {
+			m.errorMsg = err.Error()
 		}
 
-	case "e":
+	case key.Matches(msg, m.keys.Trash):
+		m.openTrash()
+
+	case key.Matches(msg, m.keys.Edit):
 		// If selection is hidden (inactive), reactivate it first without editing
 		if m.reactivateSelection() {
 			return m, nil
@@ -151,37 +240,85 @@ func (m Model) handleNormalMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.errorMsg = ""
 		}
 
-	case "w":
+	case key.Matches(msg, m.keys.Hours):
 		// If selection is hidden (inactive), reactivate it first without editing
 		if m.reactivateSelection() {
 			return m, nil
 		}
 
-		// Edit selected colleague's work/sleep hours
-		if m.cursor >= 0 && m.cursor < len(m.colleagues) && m.selectionActive {
-			ct := m.colleagues[m.cursor]
+		// Edit the work/sleep hours of the marked colleagues, else the
+		// selected one; placeholders show the first target's hours
+		if targets := m.actionTargets(); len(targets) > 0 {
+			c := m.config.Colleagues[targets[0]]
 			m.inputMode = ModeEditWorkHours
-			m.editIndex = ct.ConfigIndex
+			m.editIndex = targets[0]
+			m.editTargets = targets
 			m.pendingWorkAction = hourRangeKeep
-			m.nameInput = newHourRangeInput(fmt.Sprintf("%d-%d",
-				ct.Colleague.GetWorkStart(), ct.Colleague.GetWorkEnd()))
+			m.nameInput = newHourRangeInput(fmt.Sprintf("%d-%d", c.GetWorkStart(), c.GetWorkEnd()))
 			m.nameInput.Focus()
 			m.errorMsg = ""
 		}
 
-	case "f":
+	case key.Matches(msg, m.keys.Tags):
+		// If selection is hidden (inactive), reactivate it first without editing
+		if m.reactivateSelection() {
+			return m, nil
+		}
+
+		if targets := m.actionTargets(); len(targets) > 0 {
+			m.inputMode = ModeEditTags
+			m.editIndex = targets[0]
+			m.editTargets = targets
+			m.nameInput = newTagsInput(m.config.Colleagues[targets[0]].Tags)
+			m.nameInput.Focus()
+			m.errorMsg = ""
+		}
+
+	case key.Matches(msg, m.keys.Format):
 		// Toggle time format
 		if err := m.toggleTimeFormat(); err != nil {
 			m.errorMsg = err.Error()
 		}
 
-	case "?", "h":
+	case key.Matches(msg, m.keys.Help):
 		// Show help
 		m.inputMode = ModeHelp
 
-	case "t":
+	case key.Matches(msg, m.keys.Timeline):
 		// Enter timeline mode
 		m.inputMode = ModeTimeline
+
+	case key.Matches(msg, m.keys.Split):
+		if err := m.toggleSplitView(); err != nil {
+			m.errorMsg = err.Error()
+		}
+
+	case key.Matches(msg, m.keys.Profiles):
+		m.openProfileSwitcher()
+
+	case key.Matches(msg, m.keys.Anchor):
+		m.cycleAnchor()
+
+	case key.Matches(msg, m.keys.Sort):
+		if err := m.cycleSortBy(); err != nil {
+			m.errorMsg = err.Error()
+		}
+
+	case key.Matches(msg, m.keys.Filter):
+		m.openFilter()
+
+	case key.Matches(msg, m.keys.Command):
+		m.openCommandLine()
+
+	case key.Matches(msg, m.keys.Undo):
+		if err := m.undo(); err != nil {
+			m.errorMsg = err.Error()
+		}
+
+	case key.Matches(msg, m.keys.Redo):
+		if err := m.redo(); err != nil {
+			m.errorMsg = err.Error()
+		}
 	}
 
 	return m, nil
@@ -217,11 +354,15 @@ func (m Model) handleSearchTimezoneMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				m.errorMsg = err.Error()
 			} else {
 				m.exitToNormal()
+				// Select the new entry (appended last in config order,
+				// wherever the sort order places it) and scroll it into view
 				m.cursor = len(m.colleagues) - 1
-				// Scroll the new entry into view (it's appended last)
-				if m.cursor >= m.scrollOffset+MaxVisible {
-					m.scrollOffset = m.cursor - MaxVisible + 1
+				for i, ct := range m.colleagues {
+					if ct.ConfigIndex == len(m.config.Colleagues)-1 {
+						m.cursor = i
+					}
 				}
+				m.scrollToCursor()
 				m.activateSelection()
 			}
 		}
@@ -333,28 +474,46 @@ func (m Model) handleEditSleepHoursMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
-		switch m.pendingWorkAction {
-		case hourRangeReset:
-			err = m.applyWorkHours(m.editIndex, nil, nil)
-		case hourRangeSet:
-			err = m.applyWorkHours(m.editIndex, HourPtr(m.pendingWorkStart), HourPtr(m.pendingWorkEnd))
-		}
-		if err != nil {
+		// Work and sleep hours are one edit: a single undo step and save
+		work := hourRangeEdit{m.pendingWorkAction, m.pendingWorkStart, m.pendingWorkEnd}
+		sleep := hourRangeEdit{action, start, end}
+		if err := m.applyHours(m.editTargetIndices(), work, sleep); err != nil {
 			m.errorMsg = err.Error()
 			return m, nil
 		}
 
-		switch action {
-		case hourRangeReset:
-			err = m.applySleepHours(m.editIndex, nil, nil)
-		case hourRangeSet:
-			err = m.applySleepHours(m.editIndex, HourPtr(start), HourPtr(end))
+		m.exitToNormal()
+		m.activateSelection()
+		return m, nil
+
+	case "esc":
+		m.exitToNormal()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.nameInput, cmd = m.nameInput.Update(msg)
+	return m, cmd
+}
+
+// handleEditTagsMode handles the tags prompt; Enter applies the edit
+// to every target as one save
+func (m Model) handleEditTagsMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "enter":
+		if strings.TrimSpace(m.nameInput.Value()) == "" {
+			m.exitToNormal() // Keep the current tags
+			return m, nil
 		}
+		edit, err := parseTagEdit(m.nameInput.Value())
 		if err != nil {
 			m.errorMsg = err.Error()
 			return m, nil
 		}
-
+		if err := m.applyTags(m.editTargetIndices(), edit); err != nil {
+			m.errorMsg = err.Error()
+			return m, nil
+		}
 		m.exitToNormal()
 		m.activateSelection()
 		return m, nil
@@ -369,6 +528,53 @@ func (m Model) handleEditSleepHoursMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return m, cmd
 }
 
+// handleConfirmDeleteMode handles the delete confirmation prompt
+func (m Model) handleConfirmDeleteMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "y", "Y":
+		targets := m.editTargets
+		m.exitToNormal()
+		if err := m.confirmDelete(targets); err != nil {
+			m.errorMsg = err.Error()
+		}
+	case "n", "N", "esc":
+		m.exitToNormal()
+	}
+	return m, nil
+}
+
+// handleTrashMode handles input on the trash screen
+func (m Model) handleTrashMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case msg.String() == "enter":
+		if err := m.restoreFromTrash(m.trashCursor); err != nil {
+			m.errorMsg = err.Error()
+		}
+		m.trashCursor = max(min(m.trashCursor, len(m.config.Trash)-1), 0)
+
+	case msg.String() == "x":
+		if err := m.purgeFromTrash(m.trashCursor); err != nil {
+			m.errorMsg = err.Error()
+		}
+		m.trashCursor = max(min(m.trashCursor, len(m.config.Trash)-1), 0)
+
+	case key.Matches(msg, m.keys.Back, m.keys.Quit, m.keys.Trash):
+		m.exitToNormal()
+
+	case key.Matches(msg, m.keys.Up):
+		if m.trashCursor > 0 {
+			m.trashCursor--
+		}
+
+	case key.Matches(msg, m.keys.Down):
+		if m.trashCursor < len(m.config.Trash)-1 {
+			m.trashCursor++
+		}
+	}
+
+	return m, nil
+}
+
 // handleHelpMode handles input in help screen
 func (m Model) handleHelpMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	m.inputMode = ModeNormal
@@ -377,64 +583,253 @@ func (m Model) handleHelpMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 // handleTimelineMode handles input in timeline visualization mode
 func (m Model) handleTimelineMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	switch msg.String() {
-	case "q", "t":
+	m.errorMsg = "" // Shown until the next key, as there is no edit flow to fix it in
+
+	switch {
+	case key.Matches(msg, m.keys.Quit, m.keys.Timeline):
 		// Return to normal mode (scrub does not persist across modes)
 		m.inputMode = ModeNormal
 		m.timeOffset = 0
 
-	case "esc":
-		// First esc resets an active scrub; a second exits timeline mode
+	case key.Matches(msg, m.keys.Back):
+		// First esc resets an active scrub, then any filter; then exits
+		// timeline mode
 		if m.timeOffset != 0 {
 			m.timeOffset = 0
+		} else if m.filterQuery != "" {
+			m.setFilter("")
 		} else {
 			m.inputMode = ModeNormal
 		}
 
-	case "left":
-		// Scrub time backward one hour
-		m.timeOffset -= time.Hour
+	case m.handleScrubKey(msg):
+		// Scrubbed
 
-	case "right":
-		// Scrub time forward one hour
-		m.timeOffset += time.Hour
+	case key.Matches(msg, m.keys.Goto):
+		m.openGoto()
 
-	case "up", "k":
+	case key.Matches(msg, m.keys.Week):
+		m.openWeek()
+
+	case key.Matches(msg, m.keys.Window):
+		if err := m.cycleTimelineWindow(); err != nil {
+			m.errorMsg = err.Error()
+		}
+
+	case key.Matches(msg, m.keys.RowLabels):
+		if err := m.cycleRowLabels(); err != nil {
+			m.errorMsg = err.Error()
+		}
+
+	case key.Matches(msg, m.keys.Up):
 		// Scroll up
 		if m.scrollOffset > 0 {
 			m.scrollOffset--
 		}
 
-	case "down", "j":
+	case key.Matches(msg, m.keys.Down):
 		// Scroll down
-		maxScroll := max(len(m.colleagues)-MaxVisible, 0)
+		maxScroll := max(len(m.colleagues)-m.visibleRows(), 0)
 		if m.scrollOffset < maxScroll {
 			m.scrollOffset++
 		}
 
-	case "c":
+	case key.Matches(msg, m.keys.Colors):
 		// Cycle through color schemes (auto-discover from registered schemes)
-		m.config.ColorScheme = GetNextColorScheme(m.config.ColorScheme)
-
-		if err := m.saveConfig(); err != nil {
+		if err := m.cycleColorScheme(); err != nil {
 			m.errorMsg = err.Error()
 		}
 
-	case "m":
+	case key.Matches(msg, m.keys.Mode):
 		// Toggle timeline mode
-		if m.config.TimelineMode == "individual" {
-			m.config.TimelineMode = "shared"
-		} else {
-			m.config.TimelineMode = "individual"
-		}
-
-		if err := m.saveConfig(); err != nil {
+		if err := m.toggleTimelineMode(); err != nil {
 			m.errorMsg = err.Error()
 		}
 
-	case "?", "h":
+	case key.Matches(msg, m.keys.Help):
 		// Show help
 		m.inputMode = ModeHelp
+
+	case key.Matches(msg, m.keys.Profiles):
+		m.openProfileSwitcher()
+
+	case key.Matches(msg, m.keys.Anchor):
+		// No selection in timeline mode: step through colleagues' zones
+		m.cycleAnchor()
+
+	case key.Matches(msg, m.keys.Sort):
+		if err := m.cycleSortBy(); err != nil {
+			m.errorMsg = err.Error()
+		}
+
+	case key.Matches(msg, m.keys.Filter):
+		m.openFilter()
+
+	case key.Matches(msg, m.keys.Command):
+		m.openCommandLine()
+
+	case key.Matches(msg, m.keys.Undo):
+		if err := m.undo(); err != nil {
+			m.errorMsg = err.Error()
+		}
+
+	case key.Matches(msg, m.keys.Redo):
+		if err := m.redo(); err != nil {
+			m.errorMsg = err.Error()
+		}
+	}
+
+	return m, nil
+}
+
+// openProfileSwitcher shows the profile list with the active profile
+// selected; it returns to the current mode when closed
+func (m *Model) openProfileSwitcher() {
+	m.returnMode = m.inputMode
+	m.inputMode = ModeProfiles
+	m.profileCursor = 0
+	for i, name := range m.fullConfig.ProfileNames() {
+		if name == m.profile {
+			m.profileCursor = i
+		}
+	}
+}
+
+// handleFilterMode handles input in the filter prompt. The list
+// narrows as the query is typed; Enter keeps the filter, Esc clears it.
+func (m Model) handleFilterMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "enter":
+		m.inputMode = m.returnMode
+		return m, nil
+
+	case "esc":
+		m.setFilter("")
+		m.inputMode = m.returnMode
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.nameInput, cmd = m.nameInput.Update(msg)
+	if strings.TrimSpace(m.nameInput.Value()) != m.filterQuery {
+		m.setFilter(m.nameInput.Value())
+	}
+	return m, cmd
+}
+
+// handleCommandMode handles the ':' command line
+func (m Model) handleCommandMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "enter":
+		m.submitCommandLine()
+		return m, nil
+
+	case "esc":
+		m.inputMode = m.returnMode
+		m.commandHints = nil
+		return m, nil
+
+	case "tab":
+		m.completeCommandLine()
+		return m, nil
+
+	case "up":
+		m.browseCommandHistory(-1)
+		return m, nil
+
+	case "down":
+		m.browseCommandHistory(1)
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.nameInput, cmd = m.nameInput.Update(msg)
+	return m, cmd
+}
+
+// handleWeekMode handles input on the week heatmap
+func (m Model) handleWeekMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case msg.String() == "enter":
+		m.jumpToWeekCell()
+
+	case key.Matches(msg, m.keys.Back, m.keys.Quit, m.keys.Week):
+		m.inputMode = ModeTimeline
+
+	case key.Matches(msg, m.keys.Up):
+		m.moveWeekCursor(-1, 0)
+
+	case key.Matches(msg, m.keys.Down):
+		m.moveWeekCursor(1, 0)
+
+	case key.Matches(msg, m.keys.ScrubBack):
+		m.moveWeekCursor(0, -1)
+
+	case key.Matches(msg, m.keys.ScrubForward):
+		m.moveWeekCursor(0, 1)
+
+	case key.Matches(msg, m.keys.PrevDay):
+		m.scrubDays(-7)
+
+	case key.Matches(msg, m.keys.NextDay):
+		m.scrubDays(7)
+	}
+
+	return m, nil
+}
+
+// handleGotoMode handles the timeline's go-to-time prompt; an
+// unparseable time keeps the prompt open with the error shown
+func (m Model) handleGotoMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "enter":
+		now := time.Now()
+		target, err := parseGotoTarget(m.nameInput.Value(), now, m.referenceTimezone())
+		if err != nil {
+			m.errorMsg = err.Error()
+			return m, nil
+		}
+		m.timeOffset = target.Sub(now)
+		m.inputMode = m.returnMode
+		m.errorMsg = ""
+		return m, nil
+
+	case "esc":
+		m.inputMode = m.returnMode
+		m.errorMsg = ""
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.nameInput, cmd = m.nameInput.Update(msg)
+	return m, cmd
+}
+
+// handleProfilesMode handles input in the profile switcher
+func (m Model) handleProfilesMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	names := m.fullConfig.ProfileNames()
+
+	switch {
+	case msg.String() == "enter":
+		if m.profileCursor >= 0 && m.profileCursor < len(names) {
+			if err := m.switchProfile(names[m.profileCursor]); err != nil {
+				m.errorMsg = err.Error()
+			}
+		}
+		m.inputMode = m.returnMode
+
+	case key.Matches(msg, m.keys.Back, m.keys.Quit, m.keys.Profiles):
+		m.inputMode = m.returnMode
+
+	case key.Matches(msg, m.keys.Up):
+		if m.profileCursor > 0 {
+			m.profileCursor--
+		}
+
+	case key.Matches(msg, m.keys.Down):
+		if m.profileCursor < len(names)-1 {
+			m.profileCursor++
+		}
 	}
 
 	return m, nil
@@ -25,15 +25,17 @@ func getNameStyle(ct ColleagueTime) lipgloss.Style {
 func renderScrollIndicators(scrollOffset, visible, total int) (string, string) {
 	var topIndicator, bottomIndicator string
 
+	// The newline goes after rendering: inside, it would leave a padded
+	// blank line that the next row is written onto
 	if scrollOffset > 0 {
 		topIndicator = footerStyle.Render(
-			fmt.Sprintf("  ▲ %d more above\n", scrollOffset))
+			fmt.Sprintf("  ▲ %d more above", scrollOffset)) + "\n"
 	}
 
 	if scrollOffset+visible < total {
 		remaining := total - (scrollOffset + visible)
 		bottomIndicator = footerStyle.Render(
-			fmt.Sprintf("  ▼ %d more below\n", remaining))
+			fmt.Sprintf("  ▼ %d more below", remaining)) + "\n"
 	}
 
 	return topIndicator, bottomIndicator
@@ -45,18 +47,26 @@ func (m Model) View() string {
 		return m.renderHelp()
 	}
 
-	if m.inputMode == ModeTimeline {
+	if m.viewMode() == ModeTimeline {
 		return m.renderTimeline()
 	}
 
+	if m.inputMode == ModeProfiles {
+		return m.renderProfiles()
+	}
+
+	if m.inputMode == ModeTrash {
+		return m.renderTrash()
+	}
+
+	if m.inputMode == ModeWeek {
+		return m.renderWeek()
+	}
+
 	var b strings.Builder
 
 	// Header
-	localTime := time.Now().In(m.localTimezone)
-	header := fmt.Sprintf("🌍 World Clock - Local Time: %s (%s)",
-		FormatTime(localTime, m.config.TimeFormat),
-		FormatDate(localTime))
-	b.WriteString(headerStyle.Render(header))
+	b.WriteString(m.renderHeader())
 	b.WriteString("\n")
 
 	// Input mode prompts
@@ -101,9 +111,22 @@ func (m Model) View() string {
 		b.WriteString("\n")
 		b.WriteString(footerStyle.Render("Enter apply both • blank keep • \"default\" reset • Esc cancel all"))
 
+	case ModeConfirmDelete:
+		b.WriteString(m.renderConfirmDelete())
+
+	case ModeEditTags:
+		b.WriteString(promptStyle.Render(fmt.Sprintf("Edit '%s' - Tags: ", m.editTargetName())))
+		b.WriteString(m.nameInput.View())
+		b.WriteString("\n")
+		b.WriteString(footerStyle.Render("Enter apply • a, b replace • +a -b add/remove • - clear • blank keep • Esc cancel"))
+
 	default:
-		// Normal mode - show colleagues
+		// Normal mode - show colleagues (and the detail pane, if open)
 		b.WriteString(m.renderColleagues())
+		if detail := m.renderDetail(); detail != "" {
+			b.WriteString("\n")
+			b.WriteString(detail)
+		}
 	}
 
 	// Error message
@@ -111,18 +134,111 @@ func (m Model) View() string {
 		b.WriteString("\n")
 		b.WriteString(errorStyle.Render("Error: " + m.errorMsg))
 	}
+	b.WriteString(m.renderStatus())
 
-	// Footer with keybindings (only in normal mode)
-	if m.inputMode == ModeNormal {
+	// Footer with keybindings (only in normal mode); the filter prompt
+	// takes its place while open
+	switch m.inputMode {
+	case ModeNormal:
 		b.WriteString("\n")
 		b.WriteString(m.renderFooter())
+	case ModeFilter:
+		b.WriteString("\n")
+		b.WriteString(m.renderFilterPrompt())
+	case ModeCommand:
+		b.WriteString("\n")
+		b.WriteString(m.renderCommandPrompt())
+	case ModeRange:
+		b.WriteString("\n")
+		b.WriteString(m.renderRangePopup())
 	}
 
 	return b.String()
 }
 
-// editTargetName returns the name of the colleague being edited
+// renderStatus renders the transient status line (undo/redo
+// feedback), or "" when there is none
+func (m Model) renderStatus() string {
+	if m.statusMsg == "" {
+		return ""
+	}
+	return "\n" + dateStyle.Render(m.statusMsg)
+}
+
+// renderHeader renders the list view's title line
+// (displayNow applies any scrub offset from the split view)
+func (m Model) renderHeader() string {
+	localTime := m.displayNow()
+	header := fmt.Sprintf("🌍 World Clock%s - %s: %s (%s)",
+		m.profileLabel(),
+		m.referenceLabel(),
+		FormatTime(localTime, m.config.TimeFormat),
+		FormatDate(localTime))
+	if m.timeOffset != 0 {
+		header += fmt.Sprintf("  ⏩ scrubbed %s", formatScrubOffset(m.timeOffset))
+	}
+	header += m.filterLabel() + m.markedLabel()
+	return headerStyle.Render(header)
+}
+
+// profileLabel returns the header tag for the active profile, or ""
+// when the config defines no named profiles
+func (m Model) profileLabel() string {
+	if len(m.fullConfig.Profiles) == 0 {
+		return ""
+	}
+	return " [" + m.profile + "]"
+}
+
+// renderProfiles renders the profile switcher
+func (m Model) renderProfiles() string {
+	var b strings.Builder
+
+	b.WriteString(headerStyle.Render("🌍 Profiles"))
+	b.WriteString("\n")
+
+	for i, name := range m.fullConfig.ProfileNames() {
+		count := len(m.fullConfig.Colleagues)
+		if p, ok := m.fullConfig.Profiles[name]; ok {
+			count = len(p.Colleagues)
+		}
+		// The active roster lives in the view (fullConfig may lag it
+		// if a save failed)
+		if name == m.profile {
+			count = len(m.config.Colleagues)
+		}
+
+		cursor := "  "
+		style := rowStyle
+		if i == m.profileCursor {
+			cursor = "▶ "
+			style = selectedRowStyle
+		}
+
+		line := fmt.Sprintf("%s%s  %s", cursor, name,
+			dateStyle.Render(fmt.Sprintf("(%d colleagues)", count)))
+		if name == m.profile {
+			line += "  " + workingStyle.Render("● active")
+		}
+		b.WriteString(style.Render(line))
+		b.WriteString("\n")
+	}
+
+	if len(m.fullConfig.Profiles) == 0 {
+		b.WriteString(footerStyle.Render("Add named rosters under profiles: in the config to switch between them."))
+		b.WriteString("\n")
+	}
+
+	b.WriteString(footerStyle.Render("↑/↓ select • Enter switch • Esc cancel"))
+	return b.String()
+}
+
+// editTargetName returns the name of the colleague being edited, or
+// "N colleagues" for a bulk edit
 func (m Model) editTargetName() string {
+	if targets := m.editTargetIndices(); len(targets) > 1 {
+		return m.targetsName(targets)
+	}
 	if m.editIndex >= 0 && m.editIndex < len(m.config.Colleagues) {
 		return m.config.Colleagues[m.editIndex].Name
 	}
@@ -131,93 +247,103 @@ func (m Model) editTargetName() string {
 
 // renderColleagues renders the list of colleagues with scrolling
 func (m Model) renderColleagues() string {
+	// Your own row stays pinned above the list, whatever its scroll,
+	// sort or filter
+	layout := m.listLayout()
+	me := m.renderMeRow(layout)
+
 	if len(m.colleagues) == 0 {
-		return footerStyle.Render("No colleagues configured. Press 'a' to add one.")
+		if m.filterQuery != "" {
+			return me + footerStyle.Render(fmt.Sprintf("No colleagues match '%s'", m.filterQuery))
+		}
+		return me + footerStyle.Render(fmt.Sprintf("No colleagues configured. Press '%s' to add one.", m.keys.Add.Help().Key))
 	}
 
 	var b strings.Builder
+	b.WriteString(me)
 
 	// Calculate visible range
+	rows := m.visibleRows()
 	start := m.scrollOffset
-	end := min(start+MaxVisible, len(m.colleagues))
+	end := min(start+rows, len(m.colleagues))
 
 	// Show scroll indicators
-	topIndicator, bottomIndicator := renderScrollIndicators(m.scrollOffset, MaxVisible, len(m.colleagues))
+	topIndicator, bottomIndicator := renderScrollIndicators(m.scrollOffset, rows, len(m.colleagues))
 	b.WriteString(topIndicator)
 
-	// Render visible colleagues
+	// Render visible colleagues (shifted by any scrub offset), each
+	// followed by its timeline bar in the split view
+	_, barWidth, split := m.splitLayout()
 	for i := start; i < end; i++ {
-		colleague := m.colleagues[i]
-		b.WriteString(m.renderColleagueRow(i, colleague))
+		colleague := m.scrubbed(m.colleagues[i])
+		row := m.renderColleagueRow(i, colleague, layout)
+		if split {
+			row = m.renderSplitRow(row, colleague, layout, barWidth)
+		}
+		b.WriteString(row)
 		b.WriteString("\n")
 	}
 
 	// Show bottom scroll indicator
 	b.WriteString(bottomIndicator)
 
+	// The split view's overlap row and hour labels
+	b.WriteString(m.renderSplitExtras())
+
 	return b.String()
 }
 
-// renderColleagueRow renders a single colleague row
-func (m Model) renderColleagueRow(index int, ct ColleagueTime) string {
-	cursor := "  "
+// renderColleagueRow renders a single colleague row with the columns
+// and widths chosen by listLayout
+func (m Model) renderColleagueRow(index int, ct ColleagueTime, layout listLayout) string {
+	cursor, mark := " ", " "
 	style := rowStyle
 
 	if m.cursor >= 0 && index == m.cursor && m.selectionActive {
-		cursor = "▶ "
+		cursor = "▶"
 		style = selectedRowStyle
 	}
+	if m.marked[ct.ConfigIndex] {
+		mark = "✓"
+	}
+	cursor += mark
 
 	// Broken entries stay visible so they can be fixed (e) or removed (d)
 	if ct.InvalidTimezone {
 		line := fmt.Sprintf("%s%s %s  %s",
 			cursor,
 			invalidStyle.Render("⚠"),
-			ct.Colleague.Name,
+			truncateOrPad(ct.Colleague.Name, layout.widths["name"]),
 			invalidStyle.Render(fmt.Sprintf("invalid timezone %q — edit or delete", ct.Colleague.Timezone)),
 		)
 		return style.Render(line)
 	}
 
-	// Status indicator (working/off-hours/weekend)
-	var statusIndicator string
-	timeStyle := getNameStyle(ct)
-
-	if ct.IsWeekend {
-		statusIndicator = "◆"
-	} else if ct.IsWorkingTime {
-		statusIndicator = "●"
-	} else {
-		statusIndicator = "○"
+	// Columns padded so they line up across rows
+	var line strings.Builder
+	line.WriteString(cursor)
+	for i, name := range layout.columns {
+		if i > 0 {
+			line.WriteString(strings.Repeat(" ", columnGap(layout.columns[i-1])))
+		}
+		col := listColumns[name]
+		cell := truncateOrPad(col.value(m, ct), layout.widths[name])
+		if col.style != nil {
+			cell = col.style(ct).Render(cell)
+		}
+		line.WriteString(cell)
 	}
 
-	// Format time
-	timeStr := FormatTime(ct.CurrentTime, m.config.TimeFormat)
-	dateStr := FormatDate(ct.CurrentTime)
-
-	// Build row
-	line := fmt.Sprintf("%s%s %s  %s  %s  %s",
-		cursor,
-		statusIndicator,
-		ct.Colleague.Name,
-		timeStyle.Render(timeStr),
-		offsetStyle.Render(ct.Offset),
-		dateStyle.Render(dateStr),
-	)
-
-	// Upcoming DST transition warning (within DSTLookahead). The date is
-	// taken an hour after the transition: for midnight fall-backs (e.g.
-	// Chile, 00:00 -> 23:00) the moment itself lands on the previous
-	// calendar day, but people name the change after the day being
-	// entered.
-	if ct.HasDSTChange {
-		warn := fmt.Sprintf("⚡%s %s",
-			formatOffsetString(ct.DSTDeltaHours),
-			ct.DSTChangeAt.Add(time.Hour).Format("Jan 2"))
-		line += "  " + offsetStyle.Render(warn)
-	}
+	return style.Render(line.String())
+}
 
-	return style.Render(line)
+// formatDSTWarning formats an upcoming offset change, e.g. "⚡-1h Nov 1".
+// The date is taken an hour after the transition: for midnight
+// fall-backs (e.g. Chile, 00:00 -> 23:00) the moment itself lands on
+// the previous calendar day, but people name the change after the day
+// being entered.
+func formatDSTWarning(deltaHours float64, at time.Time) string {
+	return fmt.Sprintf("⚡%s %s", formatOffsetString(deltaHours), at.Add(time.Hour).Format("Jan 2"))
 }
 
 // renderSearchResults renders the timezone search results
@@ -232,7 +358,7 @@ func (m Model) renderSearchResults() string {
 	var b strings.Builder
 
 	// Calculate visible range
-	maxSearchVisible := 10
+	maxSearchVisible := m.searchVisibleRows()
 	start := m.searchScrollOffset
 	end := min(start+maxSearchVisible, len(m.searchResults))
 
@@ -285,66 +411,147 @@ func (m Model) renderSearchResult(index int, result SearchResult) string {
 	return style.Render(line)
 }
 
-// renderFooter renders the keybindings help
+// renderFooter renders the keybindings help from the active key map
 func (m Model) renderFooter() string {
-	help := []string{
-		"↑/k up",
-		"↓/j down",
-		"a add",
-		"e edit",
-		"w hours",
-		"d delete",
-		"f format",
-		"t timeline",
-		"? help",
-		"q quit",
-	}
-	return footerStyle.Render(strings.Join(help, " • "))
+	k := m.keys
+
+	// Only advertise the switcher when there is something to switch to
+	profiles := ""
+	if len(m.fullConfig.Profiles) > 0 {
+		profiles = footerItem(k.Profiles, "")
+	}
+
+	// The split view's bars scrub as the timeline's do
+	scrub := ""
+	if m.splitActive() && k.ScrubBack.Enabled() && k.ScrubForward.Enabled() {
+		scrub = primaryKeyLabel(k.ScrubBack) + "/" + primaryKeyLabel(k.ScrubForward) + " scrub time"
+	}
+	back := ""
+	if m.timeOffset != 0 {
+		back = footerItem(k.Back, "back to now")
+	}
+
+	help := joinFooter(
+		footerItem(k.Up, ""),
+		footerItem(k.Down, ""),
+		footerItem(k.Add, ""),
+		footerItem(k.Edit, ""),
+		footerItem(k.Hours, ""),
+		footerItem(k.Delete, ""),
+		footerItem(k.Details, ""),
+		footerItem(k.Mark, ""),
+		footerItem(k.Format, ""),
+		footerItem(k.Timeline, ""),
+		footerItem(k.Split, "split: "+m.splitLabel()),
+		scrub,
+		back,
+		footerItem(k.Anchor, ""),
+		footerItem(k.Sort, "sort: "+m.config.SortBy),
+		footerItem(k.Filter, ""),
+		footerItem(k.Command, ""),
+		footerItem(k.Undo, ""),
+		profiles,
+		footerItem(k.Help, ""),
+		footerItem(k.Quit, ""),
+	)
+	return footerStyle.Render(help)
 }
 
-// renderHelp renders the help screen
+// renderHelp renders the help screen; key columns come from the
+// active key map so rebound keys are documented correctly
 func (m Model) renderHelp() string {
-	help := `
-🌍 World Clock - Help
-
-NAVIGATION
-  ↑, k         Move cursor up
-  ↓, j         Move cursor down
-
-ACTIONS
-  a            Add a new colleague
-  e            Edit selected colleague (name and timezone)
-  w            Edit selected colleague's work/sleep hours
-  d            Delete selected colleague
-  f            Toggle time format (12h/24h)
-  t            Timeline visualization mode
-
-TIMELINE MODE
-  t            Return to normal mode
-  m            Toggle mode (individual/shared)
-  c            Cycle color schemes
-  ↑/↓, k/j     Scroll through colleagues
-  ←/→          Scrub time ±1h (preview future/past)
-  Esc          Back to now (or exit timeline)
+	k := m.keys
+	var b strings.Builder
 
+	b.WriteString("\n🌍 World Clock - Help\n\n")
+
+	b.WriteString("NAVIGATION\n")
+	b.WriteString(helpLine(k.Up, "Move cursor up"))
+	b.WriteString(helpLine(k.Down, "Move cursor down"))
+
+	b.WriteString("\nACTIONS\n")
+	b.WriteString(helpLine(k.Add, "Add a new colleague"))
+	b.WriteString(helpLine(k.Edit, "Edit selected colleague (name and timezone)"))
+	b.WriteString(helpLine(k.Hours, "Edit work/sleep hours of selected (or marked) colleagues"))
+	b.WriteString(helpLine(k.Delete, "Delete selected (or marked) colleagues"))
+	b.WriteString(helpLine(k.Trash, "Open the trash (restore deleted colleagues)"))
+	b.WriteString(helpLine(k.Mark, "Mark/unmark colleague for bulk actions (Esc clears marks)"))
+	b.WriteString(helpLine(k.Tags, "Edit tags of selected (or marked) colleagues"))
+	b.WriteString(helpLine(k.Details, "Show/hide details (zone, hours, countdown, DST, day bar)"))
+	b.WriteString(helpLine(k.MoveUp, "Move selected colleague up (config order)"))
+	b.WriteString(helpLine(k.MoveDown, "Move selected colleague down (config order)"))
+	b.WriteString(helpLine(k.Sort, "Cycle sort order (config, offset, name, status)"))
+	b.WriteString(helpLine(k.Filter, "Filter by name, city, country, timezone or tag:name (Esc clears)"))
+	b.WriteString(helpLine(k.Command, "Command line: add, goto, scheme, mode, sort, format, filter, profile, undo, redo, trash"))
+	b.WriteString(helpLine(k.Format, "Toggle time format (12h/24h)"))
+	b.WriteString(helpLine(k.Timeline, "Timeline visualization mode"))
+	b.WriteString(helpLine(k.Split, "Split view: timeline bars beside the list (scrub keys work there)"))
+	b.WriteString(helpLine(k.Profiles, "Switch profile (named rosters from the config)"))
+	b.WriteString(helpLine(k.Anchor, "Anchor offsets to selected colleague's zone (again to release)"))
+	b.WriteString(helpLine(k.Undo, "Undo last change (add, edit, delete, hours, format, colors, mode)"))
+	b.WriteString(helpLine(k.Redo, "Redo last undone change"))
+
+	b.WriteString("\nTIMELINE MODE\n")
+	b.WriteString(helpLine(k.Timeline, "Return to normal mode"))
+	b.WriteString(helpLine(k.Mode, "Toggle mode (individual/shared)"))
+	b.WriteString(helpLine(k.Colors, "Cycle color schemes"))
+	b.WriteString(helpLine(k.Anchor, "Cycle reference zone through colleagues (view their day)"))
+	b.WriteString(helpLine(k.Sort, "Cycle sort order"))
+	b.WriteString(helpLine(k.Filter, "Filter colleagues"))
+	b.WriteString(helpLine(k.Command, "Command line (e.g. :goto 14:00, :mode shared)"))
+	b.WriteString(helpLine(k.Up, "Scroll up"))
+	b.WriteString(helpLine(k.Down, "Scroll down"))
+	b.WriteString(helpLine(k.ScrubBack, "Scrub time -1h (preview past)"))
+	b.WriteString(helpLine(k.ScrubForward, "Scrub time +1h (preview future)"))
+	b.WriteString(helpLine(k.ScrubBackFine, "Scrub time -15m"))
+	b.WriteString(helpLine(k.ScrubForwardFine, "Scrub time +15m"))
+	b.WriteString(helpLine(k.ScrubBackDay, "Scrub time -1 day"))
+	b.WriteString(helpLine(k.ScrubForwardDay, "Scrub time +1 day"))
+	b.WriteString(helpLine(k.PrevDay, "Jump to the previous midnight"))
+	b.WriteString(helpLine(k.NextDay, "Jump to the next midnight"))
+	b.WriteString(helpLine(k.Week, "Week heatmap of team overlap (Enter jumps the timeline to a slot)"))
+	b.WriteString(helpLine(k.Window, "Cycle the window: 12h, 24h, 36h, 48h (pans with scrubbing)"))
+	b.WriteString(helpLine(k.RowLabels, "Cycle hour labels per row: off, their clock, your clock (individual mode)"))
+	b.WriteString(helpLine(k.Goto, "Go to a time (14:00, tue 10:30, +3d, 2026-11-02 09:00 Europe/London)"))
+	b.WriteString(helpLine(k.Back, "Back to now (or exit timeline)"))
+
+	b.WriteString(`
 TIMELINE LEGEND
   ░ Dark       Sleep hours (11pm-7am)
   ▓ Gray       Off-hours (awake but not working)
+  ▓ Purple     Off-hours on their weekend
   █ Green      Work hours (9am-5pm, weekdays)
-  █ Cyan       Current time (highlighted in cyan)
+  ▏▌█ Cyan     Current time (fills its cell as the time passes)
+  Yellow bg    Clock change (DST transition on the shown day)
+  Muted bg     Colleague's midnight (their date changes)
 
 STATUS INDICATORS
   ● Green      Working hours (9am-5pm, weekdays)
   ○ Gray       Off hours
   ◆ Purple     Weekend
   ⚠ Red        Invalid timezone (edit or delete to fix)
+`)
+
+	b.WriteString(`
+MOUSE
+  Click        Select a row; on a timeline bar, scrub to that time
+  Wheel        Scroll the list, timeline or search results
+  Drag         Select a range on the shared timeline (popup with everyone's local times)
+`)
+
+	b.WriteString("\nGENERAL\n")
+	b.WriteString(helpLine(k.Help, "Show this help"))
+	b.WriteString(helpLine(k.Quit, "Quit application"))
+	b.WriteString(helpLine(k.Back, "Back to now (split view), or quit application"))
+	b.WriteString(helpLine(newBinding("", "ctrl+c"), "Force quit"))
+	b.WriteString("\nCONFIG\n")
+	source := ""
+	if m.configSource != "" {
+		source = " (" + m.configSource + ")"
+	}
+	b.WriteString("  " + m.configPath + source + "\n")
+	b.WriteString("  Keys can be rebound in the config's keys: section.\n")
+	b.WriteString("\nPress any key to return...\n")
 
-GENERAL
-  ?            Show this help
-  q, Esc       Quit application
-  Ctrl+C       Force quit
-
-Press any key to return...
-`
-	return helpStyle.Render(help)
+	return helpStyle.Render(b.String())
 }
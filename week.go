@@ -0,0 +1,214 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// WeekCellWidth is how many characters each hour of the week heatmap
+// takes
+const WeekCellWidth = 2
+
+// weekShades are the heatmap glyphs from nobody to everyone working
+var weekShades = []rune{'·', '░', '▒', '▓', '█'}
+
+// weekShade picks the heatmap glyph for count of total working: the
+// partial shades scale with the count, and the full block is kept for
+// everyone
+func weekShade(count, total int) rune {
+	switch {
+	case count <= 0 || total <= 0:
+		return weekShades[0]
+	case count >= total:
+		return weekShades[len(weekShades)-1]
+	}
+	partial := len(weekShades) - 2
+	return weekShades[1+min((count*partial-1)/total, partial-1)]
+}
+
+// overlapColleagues returns the colleagues overlap counting covers and
+// its label: the marked ones if any, otherwise everyone shown; and you,
+// if the me: section asks for it
+func (m Model) overlapColleagues() (string, []ColleagueTime) {
+	label, counted := "Team overlap", m.colleagues
+	if len(m.marked) > 0 {
+		label, counted = "Marked overlap", m.markedColleagueTimes()
+	}
+	if m.me != nil && m.config.Me.Overlap {
+		// Copied so m.colleagues' backing array is left alone
+		label, counted = label+" with me", append(counted[:len(counted):len(counted)], *m.me)
+	}
+	return label, counted
+}
+
+// weekStart returns the Monday midnight (in the reference zone) of the
+// week the timeline shows
+func (m Model) weekStart() time.Time {
+	t := m.displayNow()
+	daysSinceMonday := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-daysSinceMonday, 0, 0, 0, 0, t.Location())
+}
+
+// weekCell returns the instant of a heatmap cell: day 0 is Monday
+func (m Model) weekCell(day, hour int) time.Time {
+	s := m.weekStart()
+	return time.Date(s.Year(), s.Month(), s.Day()+day, hour, 0, 0, 0, s.Location())
+}
+
+// computeWeekOverlap counts, for each day of the week (Monday first)
+// and hour, how many colleagues are working. Each day is counted on
+// its own 24h window, so a DST change mid-week shifts the days after it.
+func (m Model) computeWeekOverlap(colleagues []ColleagueTime) ([7][]int, int) {
+	var counts [7][]int
+	total := 0
+	for day := range counts {
+		w := timelineWindow{start: m.weekCell(day, 0), hours: 24}
+		counts[day], total = computeSharedOverlap(colleagues, w, 24)
+	}
+	return counts, total
+}
+
+// openWeek shows the week heatmap with the cursor on the timeline's
+// current slot
+func (m *Model) openWeek() {
+	t := m.displayNow()
+	m.inputMode = ModeWeek
+	m.weekDay = (int(t.Weekday()) + 6) % 7
+	m.weekHour = t.Hour()
+	m.errorMsg = ""
+}
+
+// moveWeekCursor moves the heatmap cursor by days and hours, stopping
+// at the grid's edges
+func (m *Model) moveWeekCursor(days, hours int) {
+	m.weekDay = max(min(m.weekDay+days, 6), 0)
+	m.weekHour = max(min(m.weekHour+hours, 23), 0)
+}
+
+// jumpToWeekCell scrubs the timeline to the heatmap cursor's slot and
+// returns to it
+func (m *Model) jumpToWeekCell() {
+	m.timeOffset = m.weekCell(m.weekDay, m.weekHour).Sub(time.Now())
+	m.inputMode = ModeTimeline
+}
+
+// weekCellColleagues splits colleagues into those working and those
+// not at instant t, each labelled with their local time
+func weekCellColleagues(colleagues []ColleagueTime, t time.Time, format string) (in, out []string) {
+	for _, ct := range colleagues {
+		if ct.InvalidTimezone {
+			continue
+		}
+		local := t.In(ct.CurrentTime.Location())
+		label := fmt.Sprintf("%s %s", ct.Colleague.Name, FormatTimeShort(local, format))
+		if barCharAt(ct.Colleague, local) == '█' {
+			in = append(in, label)
+		} else {
+			out = append(out, label)
+		}
+	}
+	return in, out
+}
+
+// renderWeek renders the week heatmap: Monday to Sunday in the
+// reference zone by hour, shaded by how many colleagues are working
+func (m Model) renderWeek() string {
+	var b strings.Builder
+
+	label, counted := m.overlapColleagues()
+	start := m.weekStart()
+	header := fmt.Sprintf("📅 Week Heatmap%s - %s: week of %s",
+		m.profileLabel(), m.referenceLabel(), start.Format("Mon, Jan 2"))
+	b.WriteString(headerStyle.Render(header + m.filterLabel() + m.markedLabel()))
+	b.WriteString("\n\n")
+
+	counts, total := m.computeWeekOverlap(counted)
+	scheme := getCurrentColorScheme(m.config.ColorScheme)
+	allStyle := lipgloss.NewStyle().Foreground(scheme.Success)
+	someStyle := lipgloss.NewStyle().Foreground(scheme.Warning)
+	noneStyle := lipgloss.NewStyle().Foreground(scheme.Muted)
+	cursorStyle := lipgloss.NewStyle().Foreground(scheme.MarkerColor).Bold(true).Reverse(true)
+	todayStyle := lipgloss.NewStyle().Foreground(scheme.MarkerColor).Bold(true)
+
+	// Hour labels every 3 hours over the cells
+	labels := []rune(strings.Repeat(" ", 24*WeekCellWidth))
+	for hour := 0; hour < 24; hour += 3 {
+		copy(labels[hour*WeekCellWidth:], []rune(hourLabelText(hour, m.config.TimeFormat)))
+	}
+	b.WriteString(offHoursStyle.Render("    " + string(labels)))
+	b.WriteString("\n")
+
+	today := m.displayNow().Format("2006-01-02")
+	for day, hours := range counts {
+		date := m.weekCell(day, 0)
+		name := date.Format("Mon")
+		if date.Format("2006-01-02") == today {
+			name = todayStyle.Render(name)
+		}
+		b.WriteString(name + " ")
+		for hour, count := range hours {
+			cell := strings.Repeat(string(weekShade(count, total)), WeekCellWidth)
+			style := noneStyle
+			switch {
+			case total > 0 && count == total:
+				style = allStyle
+			case count*2 >= total && count > 0:
+				style = someStyle
+			}
+			if day == m.weekDay && hour == m.weekHour {
+				style = cursorStyle
+			}
+			b.WriteString(style.Render(cell))
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+
+	// The cursor cell: who is in and out then
+	cell := m.weekCell(m.weekDay, m.weekHour)
+	summary := fmt.Sprintf("%s %s: %d/%d working",
+		cell.Format("Mon Jan 2"), FormatTimeShort(cell, m.config.TimeFormat), counts[m.weekDay][m.weekHour], total)
+	b.WriteString(promptStyle.Render(summary))
+	b.WriteString(dateStyle.Render("  (" + strings.ToLower(label) + ")"))
+	b.WriteString("\n")
+	in, out := weekCellColleagues(counted, cell, m.config.TimeFormat)
+	if len(in) > 0 {
+		b.WriteString(workingStyle.Render("  in:  " + strings.Join(in, ", ")))
+		b.WriteString("\n")
+	}
+	if len(out) > 0 {
+		b.WriteString(offHoursStyle.Render("  out: " + strings.Join(out, ", ")))
+		b.WriteString("\n")
+	}
+
+	if m.errorMsg != "" {
+		b.WriteString(errorStyle.Render("Error: " + m.errorMsg))
+		b.WriteString("\n")
+	}
+	partial := string(weekShades[1 : len(weekShades)-1])
+	legend := fmt.Sprintf("%s nobody • %s more working • %s a majority • %s everyone\n",
+		noneStyle.Render(string(weekShades[0])),
+		noneStyle.Render(partial),
+		someStyle.Render(partial),
+		allStyle.Render(string(weekShades[len(weekShades)-1])))
+	b.WriteString(footerStyle.Render(legend +
+		joinFooter(
+			"←/→ hour • ↑/↓ day",
+			m.weekKeysLabel(),
+			"Enter show in timeline",
+			"Esc back",
+		)))
+	return b.String()
+}
+
+// weekKeysLabel describes the week-paging keys for the footer
+func (m Model) weekKeysLabel() string {
+	k := m.keys
+	if !k.PrevDay.Enabled() || !k.NextDay.Enabled() {
+		return ""
+	}
+	return primaryKeyLabel(k.PrevDay) + "/" + primaryKeyLabel(k.NextDay) + " week"
+}
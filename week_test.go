@@ -0,0 +1,147 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestWeekShade(t *testing.T) {
+	tests := []struct {
+		count, total int
+		want         rune
+	}{
+		{0, 3, '·'},
+		{1, 3, '░'},
+		{2, 3, '▒'},
+		{3, 3, '█'},
+		{4, 5, '▓'},
+		{1, 1, '█'},
+		{0, 0, '·'},
+	}
+	for _, tt := range tests {
+		if got := weekShade(tt.count, tt.total); got != tt.want {
+			t.Errorf("weekShade(%d, %d) = %q, want %q", tt.count, tt.total, got, tt.want)
+		}
+	}
+}
+
+// TestComputeWeekOverlap tests the weekly counts against fixed zones:
+// weekdays only, with each colleague's hours shifted by their offset
+func TestComputeWeekOverlap(t *testing.T) {
+	// Scrubbed to Wednesday 2025-01-22 12:00 UTC
+	m := Model{localTimezone: time.UTC}
+	m.timeOffset = time.Date(2025, 1, 22, 12, 0, 0, 0, time.UTC).Sub(time.Now())
+	if got := m.weekStart(); !got.Equal(time.Date(2025, 1, 20, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("weekStart = %v, want Monday 2025-01-20", got)
+	}
+
+	tokyo := time.FixedZone("JST", 9*3600)
+	colleagues := []ColleagueTime{
+		{Colleague: Colleague{Name: "A"}, CurrentTime: time.Now().UTC()},
+		{Colleague: Colleague{Name: "B"}, CurrentTime: time.Now().In(tokyo)}, // Works 0-8 UTC
+	}
+	counts, total := m.computeWeekOverlap(colleagues)
+	if total != 2 {
+		t.Fatalf("total = %d, want 2", total)
+	}
+	tests := []struct {
+		day, hour, want int
+	}{
+		{0, 3, 1},  // Monday: B only
+		{0, 10, 1}, // Monday: A only
+		{0, 8, 0},  // Monday: B done, A not yet started (A from 9)
+		{4, 12, 1}, // Friday: A
+		{5, 3, 0},  // Saturday: B's Saturday too
+		{6, 12, 0}, // Sunday
+	}
+	for _, tt := range tests {
+		if got := counts[tt.day][tt.hour]; got != tt.want {
+			t.Errorf("counts[%d][%d] = %d, want %d", tt.day, tt.hour, got, tt.want)
+		}
+	}
+}
+
+// TestComputeWeekOverlapDST tests that days after a mid-week offset
+// change are counted at the new offset
+func TestComputeWeekOverlapDST(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Skip("tzdata unavailable")
+	}
+	// Week of Monday 2024-10-21; London falls back on Sunday 27th, so
+	// the following week's Monday works 9-17 UTC instead of 8-16
+	m := Model{localTimezone: time.UTC}
+	ct := ColleagueTime{Colleague: Colleague{Name: "L"}, CurrentTime: time.Now().In(london)}
+
+	m.timeOffset = time.Date(2024, 10, 23, 12, 0, 0, 0, time.UTC).Sub(time.Now())
+	before, _ := m.computeWeekOverlap([]ColleagueTime{ct})
+	m.timeOffset = time.Date(2024, 10, 30, 12, 0, 0, 0, time.UTC).Sub(time.Now())
+	after, _ := m.computeWeekOverlap([]ColleagueTime{ct})
+
+	if before[0][8] != 1 || before[0][16] != 0 {
+		t.Errorf("BST Monday: 08 = %d, 16 = %d, want 1, 0", before[0][8], before[0][16])
+	}
+	if after[0][8] != 0 || after[0][16] != 1 {
+		t.Errorf("GMT Monday: 08 = %d, 16 = %d, want 0, 1", after[0][8], after[0][16])
+	}
+}
+
+func TestWeekMode(t *testing.T) {
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+	m, _ := newReloadTestModel(t) // Alice (New York), Bob (London), Charlie (Tokyo)
+	m.inputMode = ModeTimeline
+
+	next, _ := m.handleTimelineMode(keyMsg("W"))
+	m = next.(Model)
+	if m.inputMode != ModeWeek {
+		t.Fatalf("W: inputMode = %v, want ModeWeek", m.inputMode)
+	}
+	view := stripANSI(m.View())
+	for _, want := range []string{"Week Heatmap", "Mon", "Sun", "working"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("week view missing %q", want)
+		}
+	}
+
+	// Move to Tuesday 14:00 and inspect it
+	m.weekDay, m.weekHour = 0, 15
+	for _, msg := range []tea.KeyMsg{{Type: tea.KeyDown}, {Type: tea.KeyLeft}} {
+		next, _ = m.handleWeekMode(msg)
+		m = next.(Model)
+	}
+	if m.weekDay != 1 || m.weekHour != 14 {
+		t.Fatalf("cursor = day %d hour %d, want 1, 14", m.weekDay, m.weekHour)
+	}
+	view = stripANSI(m.View())
+	if !strings.Contains(view, "in:") || !strings.Contains(view, "Charlie (Tokyo)") {
+		t.Errorf("cell details missing from view:\n%s", view)
+	}
+
+	// Enter shows that slot in the timeline
+	want := m.weekCell(1, 14)
+	next, _ = m.handleWeekMode(enter)
+	m = next.(Model)
+	if m.inputMode != ModeTimeline {
+		t.Fatalf("enter: inputMode = %v, want ModeTimeline", m.inputMode)
+	}
+	if got := m.displayNow(); got.Sub(want).Abs() > time.Second {
+		t.Errorf("timeline at %v, want %v", got, want)
+	}
+}
+
+func TestWeekCellColleagues(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*3600)
+	colleagues := []ColleagueTime{
+		{Colleague: Colleague{Name: "A"}, CurrentTime: time.Now().UTC()},
+		{Colleague: Colleague{Name: "B"}, CurrentTime: time.Now().In(tokyo)},
+		{Colleague: Colleague{Name: "C"}, InvalidTimezone: true},
+	}
+	monday10 := time.Date(2025, 1, 20, 10, 0, 0, 0, time.UTC)
+	in, out := weekCellColleagues(colleagues, monday10, "24h")
+	if strings.Join(in, ",") != "A 10:00" || strings.Join(out, ",") != "B 19:00" {
+		t.Errorf("in = %q, out = %q, want [A 10:00], [B 19:00]", in, out)
+	}
+}
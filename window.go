@@ -0,0 +1,170 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Timeline window sizes, in hours. The window key cycles through
+// TimelineWindows; the config accepts any multiple of
+// TimelineWindowStep in the min-max range.
+const (
+	DefaultTimelineHours = 24
+	MinTimelineHours     = 6
+	MaxTimelineHours     = 72
+	TimelineWindowStep   = 6
+)
+
+// TimelineWindows are the window sizes the window key cycles through
+var TimelineWindows = []int{12, 24, 36, 48}
+
+// ValidateTimelineHours checks a timeline_hours value (0 = default)
+func ValidateTimelineHours(hours int) error {
+	if hours == 0 {
+		return nil
+	}
+	if hours < MinTimelineHours || hours > MaxTimelineHours || hours%TimelineWindowStep != 0 {
+		return fmt.Errorf("timeline_hours must be a multiple of %d between %d and %d, got %d",
+			TimelineWindowStep, MinTimelineHours, MaxTimelineHours, hours)
+	}
+	return nil
+}
+
+// TimelineWindowHours returns how many hours the timeline bars span
+func (c Config) TimelineWindowHours() int {
+	if c.TimelineHours == 0 {
+		return DefaultTimelineHours
+	}
+	return c.TimelineHours
+}
+
+// CentersTimeline reports whether the timeline window is centered on
+// now (or the scrub point) rather than starting at midnight. Windows
+// shorter than a day are always centered: from midnight they would
+// hide the rest of the day.
+func (c Config) CentersTimeline() bool {
+	return c.TimelineCentered || c.TimelineWindowHours() < 24
+}
+
+// timelineWindow is the span of wall-clock time a timeline bar shows.
+// Positions map to wall-clock times from start, so labels stay on whole
+// hours even on a day with a DST change.
+type timelineWindow struct {
+	start time.Time // Whole hour, in the zone the bar is drawn for
+	hours int
+}
+
+// windowAt returns the window containing t, in t's zone: centered on
+// t's hour, or from the midnight starting t's day
+func (m Model) windowAt(t time.Time) timelineWindow {
+	hours := m.config.TimelineWindowHours()
+	hour := 0
+	if m.config.CentersTimeline() {
+		hour = t.Hour() - hours/2
+	}
+	return timelineWindow{
+		start: time.Date(t.Year(), t.Month(), t.Day(), hour, 0, 0, 0, t.Location()),
+		hours: hours,
+	}
+}
+
+// at returns the instant bar position i stands for
+func (w timelineWindow) at(i, barWidth int) time.Time {
+	s := w.start
+	seconds := i * w.hours * 3600 / barWidth
+	return time.Date(s.Year(), s.Month(), s.Day(), s.Hour(), 0, seconds, 0, s.Location())
+}
+
+// position returns the bar position showing t, or -1 if t is outside
+// the window
+func (w timelineWindow) position(t time.Time, barWidth int) int {
+	pos := w.exactPosition(t, barWidth)
+	if pos < 0 {
+		return -1
+	}
+	return int(pos)
+}
+
+// exactPosition returns where t falls on the bar in fractions of a
+// cell (2.5 is halfway through cell 2), or -1 if t is outside the window
+func (w timelineWindow) exactPosition(t time.Time, barWidth int) float64 {
+	hours := wallClock(t.In(w.start.Location())).Sub(wallClock(w.start)).Hours()
+	if hours < 0 || hours >= float64(w.hours) {
+		return -1
+	}
+	return hours / float64(w.hours) * float64(barWidth)
+}
+
+// midnights returns the wall-clock hours from start at which a new day
+// begins inside the window (not at its edges)
+func (w timelineWindow) midnights() []int {
+	var hours []int
+	for h := 1; h < w.hours; h++ {
+		if (w.start.Hour()+h)%24 == 0 {
+			hours = append(hours, h)
+		}
+	}
+	return hours
+}
+
+// wallClock returns t's wall-clock reading as a UTC time, so two
+// readings subtract to the hours between them on the clock face
+func wallClock(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, time.UTC)
+}
+
+// barCharAt classifies a colleague's local time t into a bar
+// character, taking the weekend from t's own day so a window spanning
+// several days shows each one correctly
+func barCharAt(c Colleague, t time.Time) rune {
+	ct := ColleagueTime{Colleague: c, IsWeekend: !c.IsWorkday(t)}
+	return barCharForHour(ct, float64(t.Hour())+float64(t.Minute())/60.0)
+}
+
+// cycleTimelineWindow steps to the next window size in TimelineWindows
+// and saves
+func (m *Model) cycleTimelineWindow() error {
+	hours := m.config.TimelineWindowHours()
+	next := TimelineWindows[0]
+	if i := slices.Index(TimelineWindows, hours); i >= 0 {
+		next = TimelineWindows[(i+1)%len(TimelineWindows)]
+	}
+	return m.setTimelineWindow(strconv.Itoa(next))
+}
+
+// setTimelineWindow sets the window size ("36", "36h") or alignment
+// ("center" on now, or start at midnight with "day") and saves
+func (m *Model) setTimelineWindow(arg string) error {
+	switch arg {
+	case "center", "day":
+		m.recordHistory("timeline window")
+		m.config.TimelineCentered = arg == "center"
+		return m.saveConfig()
+	}
+	hours, err := strconv.Atoi(strings.TrimSuffix(arg, "h"))
+	if err != nil {
+		return fmt.Errorf("unknown timeline window %q (use hours like 36h, center or day)", arg)
+	}
+	if err := ValidateTimelineHours(hours); err != nil {
+		return err
+	}
+	m.recordHistory("timeline window")
+	m.config.TimelineHours = hours
+	if hours == DefaultTimelineHours {
+		m.config.TimelineHours = 0 // Keep the default out of the file
+	}
+	return m.saveConfig()
+}
+
+// windowLabel describes the timeline window for the footer, e.g.
+// "36h" or "24h centered"
+func (m Model) windowLabel() string {
+	label := fmt.Sprintf("%dh", m.config.TimelineWindowHours())
+	if m.config.TimelineCentered && m.config.TimelineWindowHours() >= 24 {
+		label += " centered"
+	}
+	return label
+}
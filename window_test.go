@@ -0,0 +1,164 @@
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWindowAt(t *testing.T) {
+	now := time.Date(2025, 1, 24, 15, 40, 0, 0, time.UTC) // Friday
+
+	tests := []struct {
+		name      string
+		hours     int
+		centered  bool
+		wantStart time.Time
+	}{
+		{"default day", 0, false, time.Date(2025, 1, 24, 0, 0, 0, 0, time.UTC)},
+		{"36h from midnight", 36, false, time.Date(2025, 1, 24, 0, 0, 0, 0, time.UTC)},
+		{"24h centered", 24, true, time.Date(2025, 1, 24, 3, 0, 0, 0, time.UTC)},
+		{"48h centered starts the day before", 48, true, time.Date(2025, 1, 23, 15, 0, 0, 0, time.UTC)},
+		{"12h is always centered", 12, false, time.Date(2025, 1, 24, 9, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := Model{config: Config{TimelineHours: tt.hours, TimelineCentered: tt.centered}}
+			w := m.windowAt(now)
+			if !w.start.Equal(tt.wantStart) {
+				t.Errorf("start = %v, want %v", w.start, tt.wantStart)
+			}
+			if pos := w.position(now, w.hours); pos < 0 || !w.at(pos, w.hours).Equal(now.Truncate(time.Hour)) {
+				t.Errorf("position(now) = %d, want the cell for 15:00", pos)
+			}
+		})
+	}
+}
+
+func TestWindowPositionOutside(t *testing.T) {
+	w := timelineWindow{start: time.Date(2025, 1, 24, 0, 0, 0, 0, time.UTC), hours: 24}
+	for _, at := range []time.Time{
+		time.Date(2025, 1, 23, 23, 59, 0, 0, time.UTC),
+		time.Date(2025, 1, 25, 0, 0, 0, 0, time.UTC),
+	} {
+		if got := w.position(at, 48); got != -1 {
+			t.Errorf("position(%v) = %d, want -1", at, got)
+		}
+	}
+	// Another zone is converted first: 09:00 in UTC+9 is 00:00 UTC
+	tokyo := time.FixedZone("JST", 9*3600)
+	if got := w.position(time.Date(2025, 1, 24, 9, 0, 0, 0, tokyo), 48); got != 0 {
+		t.Errorf("position(09:00 JST) = %d, want 0", got)
+	}
+}
+
+func TestWindowMidnights(t *testing.T) {
+	tests := []struct {
+		start int
+		hours int
+		want  []int
+	}{
+		{0, 24, nil},
+		{0, 48, []int{24}},
+		{15, 48, []int{9, 33}},
+		{18, 12, []int{6}},
+	}
+	for _, tt := range tests {
+		w := timelineWindow{start: time.Date(2025, 1, 24, tt.start, 0, 0, 0, time.UTC), hours: tt.hours}
+		got := w.midnights()
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("midnights(%02d:00 + %dh) = %v, want %v", tt.start, tt.hours, got, tt.want)
+		}
+	}
+}
+
+// TestMultiDayWeekend tests that cells on different days are
+// classified on their own day: a 48h window from Friday midnight has
+// work blocks on Friday only
+func TestMultiDayWeekend(t *testing.T) {
+	m := Model{config: Config{ColorScheme: "classic", TimelineHours: 48}}
+	friday := time.Date(2025, 1, 24, 12, 0, 0, 0, time.UTC)
+	ct := ColleagueTime{Colleague: Colleague{Name: "A", Timezone: "UTC"}, CurrentTime: friday}
+
+	bar := []rune(stripANSI(m.renderIndividualBar(ct, 48)))[1:49] // 1 char per hour
+	if bar[10] != '█' {
+		t.Errorf("Friday 10:00 = %q, want work", bar[10])
+	}
+	if _, _, ticks := individualBarCells(ct, m.windowAt(friday), 48); ticks[24] != tickDay {
+		t.Errorf("Saturday 00:00 tick = %v, want the day tick", ticks[24])
+	}
+	if bar[34] == '█' {
+		t.Errorf("Saturday 10:00 = %q, want no work", bar[34])
+	}
+}
+
+func TestHourLabels(t *testing.T) {
+	m := Model{config: Config{}}
+	day := timelineWindow{start: time.Date(2025, 1, 24, 0, 0, 0, 0, time.UTC), hours: 24}
+	if got := strings.Fields(strings.Trim(stripANSI(m.renderHourLabels(day, 48, 0, false)), " \n[]")); strings.Join(got, " ") != "0 3 6 9 12 15 18 21 24" {
+		t.Errorf("24h labels = %q, want 0 3 6 9 12 15 18 21 24", got)
+	}
+
+	centered := timelineWindow{start: time.Date(2025, 1, 24, 3, 0, 0, 0, time.UTC), hours: 24}
+	if got := strings.Fields(strings.Trim(stripANSI(m.renderHourLabels(centered, 48, 0, true)), " \n[]")); strings.Join(got, " ") != "-12h -6h now +6h +12h" {
+		t.Errorf("relative labels = %q, want -12h -6h now +6h +12h", got)
+	}
+}
+
+func TestTimelineDates(t *testing.T) {
+	m, _ := newReloadTestModel(t)
+	m.config.TimelineMode = "shared"
+	if got := m.renderTimelineDates(); got != "" {
+		t.Errorf("single-day window shows dates %q", got)
+	}
+
+	m.config.TimelineHours = 48
+	dates := stripANSI(m.renderTimelineDates())
+	tomorrow := m.displayNow().AddDate(0, 0, 1).Format("Mon 2")
+	if !strings.Contains(dates, "│"+tomorrow) {
+		t.Errorf("dates = %q, want a separator before %q", dates, tomorrow)
+	}
+}
+
+func TestSetTimelineWindow(t *testing.T) {
+	m, path := newReloadTestModel(t)
+
+	if err := m.cycleTimelineWindow(); err != nil {
+		t.Fatal(err)
+	}
+	if got := m.config.TimelineWindowHours(); got != 36 {
+		t.Errorf("after cycling from 24h: %dh, want 36h", got)
+	}
+	for _, arg := range []string{"48h", "center"} {
+		if err := m.setTimelineWindow(arg); err != nil {
+			t.Fatalf("setTimelineWindow(%q): %v", arg, err)
+		}
+	}
+	for _, bad := range []string{"25h", "96", "wide"} {
+		if err := m.setTimelineWindow(bad); err == nil {
+			t.Errorf("setTimelineWindow(%q) succeeded, want an error", bad)
+		}
+	}
+
+	loaded, err := LoadConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.TimelineHours != 48 || !loaded.TimelineCentered {
+		t.Errorf("saved window = %dh centered %v, want 48h centered", loaded.TimelineHours, loaded.TimelineCentered)
+	}
+}
+
+func TestValidateTimelineHours(t *testing.T) {
+	for _, hours := range []int{0, 6, 12, 36, 72} {
+		if err := ValidateTimelineHours(hours); err != nil {
+			t.Errorf("ValidateTimelineHours(%d) = %v, want nil", hours, err)
+		}
+	}
+	for _, hours := range []int{-24, 3, 25, 78} {
+		if err := ValidateTimelineHours(hours); err == nil {
+			t.Errorf("ValidateTimelineHours(%d) = nil, want an error", hours)
+		}
+	}
+}